@@ -72,6 +72,27 @@ type HeaderValueSource struct {
 	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 	// +kubebuilder:validation:Optional
 	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	ServiceAccountTokenRef *ServiceAccountTokenSelector `json:"serviceAccountTokenRef,omitempty"`
+}
+
+// ServiceAccountTokenSelector mints a short-lived token for a service account in the resource's namespace
+type ServiceAccountTokenSelector struct {
+	// Name of the service account
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Audience of the token. Defaults to the API server audiences.
+	// +kubebuilder:validation:Optional
+	Audience string `json:"audience,omitempty"`
+	// ExpirationSeconds is the requested lifetime of the token. Tokens are refreshed before they expire.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:default=3600
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+	// Prefix is prepended to the token, for example "Bearer " for an Authorization header
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty"`
 }
 
 type Header struct {
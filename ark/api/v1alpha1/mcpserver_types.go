@@ -26,6 +26,34 @@ type MCPServerSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="1m"
 	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// ToolFilter limits which of the server's tools are discovered and can be called
+	// +kubebuilder:validation:Optional
+	ToolFilter *MCPToolFilter `json:"toolFilter,omitempty"`
+	// ConnectRetry bounds how connections to this server are retried
+	// +kubebuilder:validation:Optional
+	ConnectRetry *MCPConnectRetry `json:"connectRetry,omitempty"`
+}
+
+// MCPConnectRetry bounds the waits between connection attempts and the total time spent connecting
+type MCPConnectRetry struct {
+	// MaxBackoff caps the wait before each retry, including waits asked for by the server with Retry-After.
+	// Defaults to "30s" if not specified.
+	// +kubebuilder:validation:Optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// MaxElapsedTime bounds the time spent connecting across all attempts and waits.
+	// Defaults to "2m" if not specified.
+	// +kubebuilder:validation:Optional
+	MaxElapsedTime *metav1.Duration `json:"maxElapsedTime,omitempty"`
+}
+
+// MCPToolFilter selects tools by name or glob pattern (e.g., "get_*"). Deny takes precedence over allow.
+type MCPToolFilter struct {
+	// Allow lists the tools that are exposed; all tools are allowed when empty
+	// +kubebuilder:validation:Optional
+	Allow []string `json:"allow,omitempty"`
+	// Deny lists the tools that are never exposed
+	// +kubebuilder:validation:Optional
+	Deny []string `json:"deny,omitempty"`
 }
 
 // MCPServerStatus defines the observed state of MCPServer
@@ -14,15 +14,54 @@ type TeamMember struct {
 type TeamSelectorSpec struct {
 	Agent          string `json:"agent,omitempty"`
 	SelectorPrompt string `json:"selectorPrompt,omitempty"`
+	// MaxHistoryMessages limits the selector prompt to the most recent messages; all messages are included when unset
+	// +kubebuilder:validation:Minimum=0
+	MaxHistoryMessages int `json:"maxHistoryMessages,omitempty"`
+	// DefaultMember is selected when there is no conversation yet; the first member is used when unset
+	DefaultMember string `json:"defaultMember,omitempty"`
+	// CacheSelections reuses the selector's decision when the same conversation comes up again within one team run
+	CacheSelections bool `json:"cacheSelections,omitempty"`
+}
+
+// TeamRouterSpec maps the input to exactly one member. Rules are checked in order, then the agent, if set, classifies
+// input no rule matched. Unmatched input goes to the default member.
+type TeamRouterSpec struct {
+	Rules []TeamRouterRule `json:"rules,omitempty"`
+	// Agent classifies the input by answering with a member name
+	Agent string `json:"agent,omitempty"`
+	// DefaultMember runs when the input matches no rule and the agent does not name a member
+	DefaultMember string `json:"defaultMember,omitempty"`
+}
+
+// TeamRouterRule routes input to a member. When both contains and matches are set, both must match.
+type TeamRouterRule struct {
+	Member string `json:"member"`
+	// Contains matches when the input contains this text, ignoring case
+	Contains string `json:"contains,omitempty"`
+	// Matches is a regular expression the input must match
+	Matches string `json:"matches,omitempty"`
 }
 
 type TeamGraphEdge struct {
 	From string `json:"from"`
 	To   string `json:"to"`
+	// Condition limits the edge to outputs of the from member that match. Edges without one are always taken.
+	Condition *TeamGraphEdgeCondition `json:"condition,omitempty"`
+}
+
+// TeamGraphEdgeCondition matches the last message of the member the edge starts from. When both fields are set, both must match.
+type TeamGraphEdgeCondition struct {
+	// Contains matches when the message contains this text, ignoring case
+	Contains string `json:"contains,omitempty"`
+	// Matches is a regular expression the message must match
+	Matches string `json:"matches,omitempty"`
 }
 
 type TeamGraphSpec struct {
 	Edges []TeamGraphEdge `json:"edges"`
+	// MaxConcurrency lets a member have several outgoing edges and runs up to this many ready members at once
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
 }
 
 type TeamSpec struct {
@@ -32,6 +71,14 @@ type TeamSpec struct {
 	MaxTurns    *int              `json:"maxTurns,omitempty"`
 	Selector    *TeamSelectorSpec `json:"selector,omitempty"`
 	Graph       *TeamGraphSpec    `json:"graph,omitempty"`
+	Router      *TeamRouterSpec   `json:"router,omitempty"`
+	// Timeout bounds the wall-clock time of the whole team run (e.g., "30s", "5m")
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retries re-runs the whole team from the start, up to this many times, when a run fails with a transient error
+	// +kubebuilder:validation:Minimum=0
+	Retries *int `json:"retries,omitempty"`
+	// RetryBackoff is the wait before the first retry, doubled for each further retry (default "1s")
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
 }
 
 type TeamStatus struct{}
@@ -23,6 +23,9 @@ type MCPToolRef struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	ToolName string `json:"toolName"`
+	// Reuse results of calls with identical arguments within a query. Only enable for idempotent tools.
+	// +kubebuilder:validation:Optional
+	CacheResults bool `json:"cacheResults,omitempty"`
 }
 
 // AgentToolRef defines a reference to an Agent Tool.
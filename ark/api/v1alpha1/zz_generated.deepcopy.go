@@ -854,6 +854,11 @@ func (in *HeaderValueSource) DeepCopyInto(out *HeaderValueSource) {
 		*out = new(corev1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountTokenRef != nil {
+		in, out := &in.ServiceAccountTokenRef, &out.ServiceAccountTokenRef
+		*out = new(ServiceAccountTokenSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderValueSource.
@@ -866,6 +871,31 @@ func (in *HeaderValueSource) DeepCopy() *HeaderValueSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPConnectRetry) DeepCopyInto(out *MCPConnectRetry) {
+	*out = *in
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxElapsedTime != nil {
+		in, out := &in.MaxElapsedTime, &out.MaxElapsedTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPConnectRetry.
+func (in *MCPConnectRetry) DeepCopy() *MCPConnectRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPConnectRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServer) DeepCopyInto(out *MCPServer) {
 	*out = *in
@@ -951,6 +981,16 @@ func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ToolFilter != nil {
+		in, out := &in.ToolFilter, &out.ToolFilter
+		*out = new(MCPToolFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectRetry != nil {
+		in, out := &in.ConnectRetry, &out.ConnectRetry
+		*out = new(MCPConnectRetry)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
@@ -985,6 +1025,31 @@ func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolFilter) DeepCopyInto(out *MCPToolFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolFilter.
+func (in *MCPToolFilter) DeepCopy() *MCPToolFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolRef.
 func (in *MCPToolRef) DeepCopy() *MCPToolRef {
 	if in == nil {
@@ -1565,6 +1630,26 @@ func (in *Response) DeepCopy() *Response {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenSelector) DeepCopyInto(out *ServiceAccountTokenSelector) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenSelector.
+func (in *ServiceAccountTokenSelector) DeepCopy() *ServiceAccountTokenSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
@@ -1610,6 +1695,11 @@ func (in *Team) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamGraphEdge) DeepCopyInto(out *TeamGraphEdge) {
 	*out = *in
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(TeamGraphEdgeCondition)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphEdge.
@@ -1622,13 +1712,35 @@ func (in *TeamGraphEdge) DeepCopy() *TeamGraphEdge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamGraphEdgeCondition) DeepCopyInto(out *TeamGraphEdgeCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphEdgeCondition.
+func (in *TeamGraphEdgeCondition) DeepCopy() *TeamGraphEdgeCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamGraphEdgeCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamGraphSpec) DeepCopyInto(out *TeamGraphSpec) {
 	*out = *in
 	if in.Edges != nil {
 		in, out := &in.Edges, &out.Edges
 		*out = make([]TeamGraphEdge, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
 	}
 }
 
@@ -1689,6 +1801,41 @@ func (in *TeamMember) DeepCopy() *TeamMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamRouterRule) DeepCopyInto(out *TeamRouterRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamRouterRule.
+func (in *TeamRouterRule) DeepCopy() *TeamRouterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamRouterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamRouterSpec) DeepCopyInto(out *TeamRouterSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]TeamRouterRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamRouterSpec.
+func (in *TeamRouterSpec) DeepCopy() *TeamRouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamRouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamSelectorSpec) DeepCopyInto(out *TeamSelectorSpec) {
 	*out = *in
@@ -1727,6 +1874,26 @@ func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 		*out = new(TeamGraphSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(TeamRouterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
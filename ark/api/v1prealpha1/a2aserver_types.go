@@ -11,6 +11,16 @@ type A2AServerSpec struct {
 	// +kubebuilder:validation:Required
 	Address ValueSource `json:"address"`
 
+	// FallbackAddresses are tried in order when the primary address is unreachable
+	// +kubebuilder:validation:Optional
+	FallbackAddresses []ValueSource `json:"fallbackAddresses,omitempty"`
+
+	// AddressSelection controls how the address is chosen among healthy addresses during execution
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ordered;round-robin;random
+	// +kubebuilder:default="ordered"
+	AddressSelection string `json:"addressSelection,omitempty"`
+
 	// Headers for authentication and other metadata
 	// +kubebuilder:validation:Optional
 	Headers []Header `json:"headers,omitempty"`
@@ -22,6 +32,15 @@ type A2AServerSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="1m"
 	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// DiscoveryTimeout bounds each agent card request made during discovery
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30s"
+	DiscoveryTimeout *metav1.Duration `json:"discoveryTimeout,omitempty"`
+
+	// ExecutionTimeout bounds each task sent to the server. Unset or zero leaves only the query timeout.
+	// +kubebuilder:validation:Optional
+	ExecutionTimeout *metav1.Duration `json:"executionTimeout,omitempty"`
 }
 
 type A2AServerStatus struct {
@@ -29,6 +48,10 @@ type A2AServerStatus struct {
 	// +kubebuilder:validation:Optional
 	LastResolvedAddress string `json:"lastResolvedAddress,omitempty"`
 
+	// ResolvedAddresses contains the primary and fallback addresses in the order they are tried
+	// +kubebuilder:validation:Optional
+	ResolvedAddresses []string `json:"resolvedAddresses,omitempty"`
+
 	// Conditions represent the latest available observations of the A2A server's state
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -75,6 +75,13 @@ func (in *A2AServerList) DeepCopyObject() runtime.Object {
 func (in *A2AServerSpec) DeepCopyInto(out *A2AServerSpec) {
 	*out = *in
 	in.Address.DeepCopyInto(&out.Address)
+	if in.FallbackAddresses != nil {
+		in, out := &in.FallbackAddresses, &out.FallbackAddresses
+		*out = make([]ValueSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make([]Header, len(*in))
@@ -87,6 +94,16 @@ func (in *A2AServerSpec) DeepCopyInto(out *A2AServerSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.DiscoveryTimeout != nil {
+		in, out := &in.DiscoveryTimeout, &out.DiscoveryTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExecutionTimeout != nil {
+		in, out := &in.ExecutionTimeout, &out.ExecutionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A2AServerSpec.
@@ -102,6 +119,11 @@ func (in *A2AServerSpec) DeepCopy() *A2AServerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *A2AServerStatus) DeepCopyInto(out *A2AServerStatus) {
 	*out = *in
+	if in.ResolvedAddresses != nil {
+		in, out := &in.ResolvedAddresses, &out.ResolvedAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
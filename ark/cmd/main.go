@@ -27,7 +27,9 @@ import (
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+	"mckinsey.com/ark/internal/common"
 	"mckinsey.com/ark/internal/controller"
+	"mckinsey.com/ark/internal/genai"
 	"mckinsey.com/ark/internal/telemetry"
 	webhookv1 "mckinsey.com/ark/internal/webhook/v1"
 	webhookv1prealpha1 "mckinsey.com/ark/internal/webhook/v1prealpha1"
@@ -60,6 +62,9 @@ type config struct {
 	probeAddr                                        string
 	secureMetrics                                    bool
 	enableHTTP2                                      bool
+	userAgent                                        string
+	a2aMaxResponseBytes                              int
+	a2aDiscoveryRetries                              int
 }
 
 func main() {
@@ -72,6 +77,9 @@ func main() {
 	}
 
 	setupLog.Info("starting ark controller", "version", Version, "commit", GitCommit)
+	genai.SetUserAgent(result.userAgent, Version)
+	genai.SetA2AMaxResponseBytes(result.a2aMaxResponseBytes)
+	genai.SetA2ADiscoveryRetries(result.a2aDiscoveryRetries)
 
 	telemetryShutdown := telemetry.Initialize()
 	defer telemetryShutdown()
@@ -106,6 +114,12 @@ func parseFlags() struct {
 	flag.StringVar(&cfg.metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&cfg.enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&cfg.userAgent, "user-agent", "",
+		"User-Agent header sent to A2A and MCP servers. Defaults to ark/<version>.")
+	flag.IntVar(&cfg.a2aMaxResponseBytes, "a2a-max-response-bytes", genai.DefaultA2AMaxResponseBytes,
+		"Maximum size of the text assembled from one A2A agent response. Longer responses are truncated. 0 disables the limit.")
+	flag.IntVar(&cfg.a2aDiscoveryRetries, "a2a-discovery-retries", genai.DefaultA2ADiscoveryRetries,
+		"Retries of an A2A agent card request after a connection failure, timeout or server error. 0 disables retries.")
 	flag.BoolVar(&showVersion, "version", false, "Show version information and exit")
 
 	zapOpts := zap.Options{Development: true}
@@ -275,6 +289,32 @@ func setupWebhooks(mgr ctrl.Manager) {
 	}
 }
 
+// setupHealthChecks keeps liveness to the process itself, while readiness also waits for the
+// Kubernetes API server and, when enabled, the webhook server
+func setupHealthChecks(mgr ctrl.Manager) {
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+
+	apiServerCheck, err := common.APIServerReadyCheck(mgr.GetConfig(), common.DefaultAPIServerCheckTimeout)
+	if err != nil {
+		setupLog.Error(err, "unable to create API server ready check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("apiserver", apiServerCheck); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		os.Exit(1)
+	}
+
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
+}
+
 func startManager(mgr ctrl.Manager, metricsCertWatcher, webhookCertWatcher *certwatcher.CertWatcher) {
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
@@ -292,14 +332,7 @@ func startManager(mgr ctrl.Manager, metricsCertWatcher, webhookCertWatcher *cert
 		}
 	}
 
-	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
-		setupLog.Error(err, "unable to set up health check")
-		os.Exit(1)
-	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
-		setupLog.Error(err, "unable to set up ready check")
-		os.Exit(1)
-	}
+	setupHealthChecks(mgr)
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -24,6 +24,12 @@ const (
 	MCPServerSettings = ARKPrefix + "mcp-server-settings"
 )
 
+// Memory annotations
+const (
+	MemoryPruning    = ARKPrefix + "memory-pruning"
+	A2AMemoryHistory = ARKPrefix + "a2a-memory-history"
+)
+
 // ARK service annotations
 const (
 	Service   = ARKPrefix + "service"
@@ -0,0 +1,35 @@
+/* Copyright 2025. McKinsey & Company */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// DefaultAPIServerCheckTimeout bounds each readiness request to the Kubernetes API server
+const DefaultAPIServerCheckTimeout = 5 * time.Second
+
+// APIServerReadyCheck returns a readiness check that fails while the Kubernetes API server cannot be reached.
+// Liveness should not use it, so that an API server outage does not restart the controller.
+func APIServerReadyCheck(cfg *rest.Config, timeout time.Duration) (healthz.Checker, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		if err := client.RESTClient().Get().AbsPath("/version").Do(ctx).Error(); err != nil {
+			return fmt.Errorf("kubernetes API server not reachable: %w", err)
+		}
+		return nil
+	}, nil
+}
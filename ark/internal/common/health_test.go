@@ -0,0 +1,36 @@
+/* Copyright 2025. McKinsey & Company */
+
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestAPIServerReadyCheck(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"34"}`))
+	}))
+
+	check, err := APIServerReadyCheck(&rest.Config{Host: apiServer.URL}, time.Second)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	assert.NoError(t, check(req))
+
+	apiServer.Close()
+	err = check(req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "kubernetes API server not reachable")
+}
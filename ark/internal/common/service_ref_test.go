@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -219,3 +220,32 @@ func TestResolveServiceReference(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveServiceReferenceValueSources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	services := createTestServices()
+	serviceObjects := make([]client.Object, len(services))
+	for i, service := range services {
+		serviceObjects[i] = service
+	}
+	testClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(serviceObjects...).Build()
+
+	expected := "http://test-service.test-namespace.svc.cluster.local:80/a2a/agent"
+
+	mcpAddress, err := NewValueSourceResolver(testClient).ResolveValueSource(context.Background(), arkv1alpha1.ValueSource{
+		ValueFrom: &arkv1alpha1.ValueFromSource{
+			ServiceRef: &arkv1alpha1.ServiceReference{Name: "test-service", Port: "http", Path: "a2a/agent"},
+		},
+	}, "test-namespace")
+	validateSuccess(t, testCase{expected: expected}, mcpAddress, err)
+
+	a2aResolver := &ValueSourceResolverV1PreAlpha1{Client: testClient}
+	a2aAddress, err := a2aResolver.ResolveValueSource(context.Background(), arkv1prealpha1.ValueSource{
+		ValueFrom: &arkv1prealpha1.ValueFromSource{
+			ServiceRef: &arkv1prealpha1.ServiceReference{Name: "test-service", Port: "http", Path: "a2a/agent"},
+		},
+	}, "test-namespace")
+	validateSuccess(t, testCase{expected: expected}, a2aAddress, err)
+}
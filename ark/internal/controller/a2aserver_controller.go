@@ -47,6 +47,7 @@ type A2AServerReconciler struct {
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 
 func (r *A2AServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -84,6 +85,7 @@ func (r *A2AServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{RequeueAfter: a2aServer.Spec.PollInterval.Duration}, nil
 	}
 	a2aServer.Status.LastResolvedAddress = resolvedAddress
+	a2aServer.Status.ResolvedAddresses = r.resolveAddresses(ctx, &a2aServer, resolvedAddress)
 
 	log.Info("A2AServer process server", "server", a2aServer.Name)
 	return r.processServer(ctx, a2aServer)
@@ -96,6 +98,22 @@ func (r *A2AServerReconciler) getResolver() *common.ValueSourceResolverV1PreAlph
 	return r.resolver
 }
 
+// resolveAddresses returns the primary address followed by any fallback addresses that resolve successfully
+func (r *A2AServerReconciler) resolveAddresses(ctx context.Context, a2aServer *arkv1prealpha1.A2AServer, primary string) []string {
+	addresses := []string{primary}
+	resolver := r.getResolver()
+	for i, fallback := range a2aServer.Spec.FallbackAddresses {
+		address, err := resolver.ResolveValueSource(ctx, fallback, a2aServer.Namespace)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "failed to resolve fallback address", "server", a2aServer.Name, "index", i)
+			r.Recorder.Event(a2aServer, corev1.EventTypeWarning, "FallbackAddressResolutionFailed", fmt.Sprintf("Failed to resolve fallback address %d: %v", i, err))
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
 func (r *A2AServerReconciler) processServer(ctx context.Context, a2aServer arkv1prealpha1.A2AServer) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 	log.Info("a2a agent discover started", "server", a2aServer.Name, "namespace", a2aServer.Namespace)
@@ -103,9 +121,13 @@ func (r *A2AServerReconciler) processServer(ctx context.Context, a2aServer arkv1
 	// Set discovering condition
 	r.setCondition(&a2aServer, A2AServerDiscovering, metav1.ConditionTrue, "DiscoveringAgents", "Discovering agents from A2A server")
 
-	// Use the already resolved address from status
+	// Use the already resolved addresses from status, failing over to the next address in order
 	resolvedAddress := a2aServer.Status.LastResolvedAddress
-	agentCard, err := genai.DiscoverA2AAgentsWithRecorder(ctx, r.Client, resolvedAddress, a2aServer.Spec.Headers, a2aServer.Namespace, r.Recorder, &a2aServer)
+	discoveryCtx := genai.WithRetryBudget(ctx, genai.DefaultRetryBudget, func(operation string) {
+		r.Recorder.Event(&a2aServer, corev1.EventTypeWarning, "RetryBudgetExhausted", fmt.Sprintf("Retry budget exhausted, not retrying %s", operation))
+	})
+	discoveryCtx = genai.WithA2ADiscoveryTimeout(discoveryCtx, a2aServer.Spec.DiscoveryTimeout)
+	agentCard, servedBy, err := genai.DiscoverA2AAgentsWithFailover(discoveryCtx, r.Client, a2aServer.Status.ResolvedAddresses, a2aServer.Spec.Headers, a2aServer.Namespace, r.Recorder, &a2aServer)
 	if err != nil {
 		log.Error(err, "A2A agent discovery failed", "server", a2aServer.Name, "address", resolvedAddress)
 		r.Recorder.Event(&a2aServer, corev1.EventTypeWarning, "AgentDiscoveryFailed", fmt.Sprintf("Failed to discover agents from A2A server %s: %v", resolvedAddress, err))
@@ -118,6 +140,11 @@ func (r *A2AServerReconciler) processServer(ctx context.Context, a2aServer arkv1
 		return ctrl.Result{RequeueAfter: a2aServer.Spec.PollInterval.Duration}, nil
 	}
 
+	if servedBy != resolvedAddress {
+		r.Recorder.Event(&a2aServer, corev1.EventTypeNormal, "AddressFailover", fmt.Sprintf("Primary address %s unreachable, discovered agent via %s", resolvedAddress, servedBy))
+	}
+	a2aServer.Status.LastResolvedAddress = servedBy
+
 	// Set connected condition after successful discovery
 	if err := r.createAgentWithSkills(ctx, &a2aServer, agentCard); err != nil {
 		log.Error(err, "A2A agent creation failed", "server", a2aServer.Name, "agent", agentCard.Name)
@@ -48,6 +48,7 @@ type MCPServerReconciler struct {
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 
 func (r *MCPServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -139,7 +140,7 @@ func (r *MCPServerReconciler) processServer(ctx context.Context, mcpServer arkv1
 		return ctrl.Result{RequeueAfter: mcpServer.Spec.PollInterval.Duration}, nil
 	}
 
-	mcpTools, err := mcpClient.ListTools(ctx)
+	mcpTools, err := mcpClient.ListTools(ctx, mcpServer.Spec.ToolFilter)
 	if err != nil {
 		r.setCondition(&mcpServer, MCPServerDiscovering, metav1.ConditionTrue, "ServerConnectedAndToolListingFailed", err.Error())
 		r.setCondition(&mcpServer, MCPServerReady, metav1.ConditionFalse, "ToolListingFailed", "Server not ready due to tool listing failure")
@@ -209,6 +210,12 @@ func (r *MCPServerReconciler) createMCPClient(ctx context.Context, mcpServer *ar
 		timeout = parsedTimeout
 	}
 
+	ctx = genai.WithRetryBudget(ctx, genai.DefaultRetryBudget, func(operation string) {
+		r.Recorder.Event(mcpServer, corev1.EventTypeWarning, "RetryBudgetExhausted", fmt.Sprintf("Retry budget exhausted, not retrying %s", operation))
+	})
+
+	ctx = genai.WithMCPConnectRetry(ctx, mcpServer.Spec.ConnectRetry)
+
 	// MCP settings are not needed for listing tools, etc.
 	mcpClient, err := genai.NewMCPClient(ctx, mcpURL, headers, mcpServer.Spec.Transport, timeout, genai.MCPSettings{})
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
 	"mckinsey.com/ark/internal/genai"
 	"mckinsey.com/ark/internal/telemetry"
 )
@@ -355,6 +356,19 @@ func (r *QueryReconciler) reconcileQueue(ctx context.Context, query arkv1alpha1.
 		return nil, nil, fmt.Errorf("failed to resolve targets: %w", err)
 	}
 
+	ctx = genai.WithToolResultCache(ctx)
+	ctx, resources := genai.WithQueryResources(ctx)
+	defer func() {
+		if err := resources.Close(); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to close query resources", "query", query.Name)
+		}
+	}()
+	ctx = genai.WithRetryBudget(ctx, genai.DefaultRetryBudget, func(operation string) {
+		tokenCollector.EmitEvent(ctx, corev1.EventTypeWarning, "RetryBudgetExhausted", genai.BaseEvent{
+			Name:     query.Name,
+			Metadata: map[string]string{"operation": operation},
+		})
+	})
 	allResponses := r.executeTargetsInParallel(ctx, query, targets, impersonatedClient, memory, eventStream, tokenCollector)
 	return allResponses, eventStream, nil
 }
@@ -391,7 +405,7 @@ func (r *QueryReconciler) executeTargetsInParallel(ctx context.Context, query ar
 		wg.Add(1)
 		go func(target arkv1alpha1.QueryTarget) {
 			defer wg.Done()
-			responses, err := r.executeTarget(ctx, query, target, impersonatedClient, memory, eventStream, tokenCollector)
+			responses, err := r.executeTarget(ctx, query, target, impersonatedClient, targetMemory(memory, target), eventStream, tokenCollector)
 			resultChan <- targetResult{responses, err, target}
 		}(target)
 	}
@@ -402,6 +416,15 @@ func (r *QueryReconciler) executeTargetsInParallel(ctx context.Context, query ar
 	return r.processTargetResults(resultChan)
 }
 
+// targetMemory returns the memory a target reads and writes. Each team always keeps its own
+// conversation history, so a team sees the same history whether or not other teams share the query.
+func targetMemory(memory genai.MemoryInterface, target arkv1alpha1.QueryTarget) genai.MemoryInterface {
+	if target.Type == "team" {
+		return genai.ScopeMemory(memory, genai.TeamMemoryScope(target.Name))
+	}
+	return memory
+}
+
 func (r *QueryReconciler) processTargetResults(resultChan chan targetResult) []arkv1alpha1.Response {
 	var allResponses []arkv1alpha1.Response
 
@@ -685,13 +708,19 @@ func (r *QueryReconciler) executeAgent(ctx context.Context, query arkv1alpha1.Qu
 	// Execute agent with the last message as the current input and previous messages as context
 	currentMessage, contextMessages := genai.PrepareExecutionMessages(inputMessages, memoryMessages)
 
+	// A2A agents that keep their conversation in memory report the turns outside the response
+	ctx, a2aHistory := genai.WithA2AMemoryHistory(ctx)
+
 	responseMessages, err := agent.Execute(ctx, currentMessage, contextMessages, memory, eventStream)
 	if err != nil {
 		return nil, err
 	}
 
 	// Save all new messages (input + response) to memory
-	newMessages := genai.PrepareNewMessagesForMemory(inputMessages, responseMessages)
+	newMessages := genai.PrepareNewMessagesForMemory(inputMessages, a2aHistory.Merge(responseMessages))
+	if agentCRD.Annotations[annotations.MemoryPruning] == genai.TrueString {
+		newMessages = genai.PruneMessagesForMemory(newMessages)
+	}
 	if err := memory.AddMessages(ctx, query.Name, newMessages); err != nil {
 		return nil, fmt.Errorf("failed to save new messages to memory: %w", err)
 	}
@@ -720,13 +749,16 @@ func (r *QueryReconciler) executeTeam(ctx context.Context, query arkv1alpha1.Que
 	// Execute team with the last message as the current input and previous messages as context
 	currentMessage, contextMessages := genai.PrepareExecutionMessages(inputMessages, historyMessages)
 
+	// A2A members that keep their conversation in memory report the turns outside the response
+	ctx, a2aHistory := genai.WithA2AMemoryHistory(ctx)
+
 	responseMessages, err := team.Execute(ctx, currentMessage, contextMessages, memory, eventStream)
 	if err != nil {
 		return nil, err
 	}
 
 	// Save all new messages (input + response) to memory
-	newMessages := genai.PrepareNewMessagesForMemory(inputMessages, responseMessages)
+	newMessages := genai.PrepareNewMessagesForMemory(inputMessages, a2aHistory.Merge(responseMessages))
 	if err := memory.AddMessages(ctx, query.Name, newMessages); err != nil {
 		return nil, fmt.Errorf("failed to save new messages to memory: %w", err)
 	}
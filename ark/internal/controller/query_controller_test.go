@@ -241,3 +241,64 @@ var _ = Describe("Query Controller Message Serialization", func() {
 		})
 	})
 })
+
+// sessionMemory is an in-memory session store that supports scopes like the memory service
+type sessionMemory struct {
+	store   map[string][]genai.Message
+	session string
+}
+
+func (m *sessionMemory) AddMessages(_ context.Context, _ string, messages []genai.Message) error {
+	m.store[m.session] = append(m.store[m.session], messages...)
+	return nil
+}
+
+func (m *sessionMemory) GetMessages(_ context.Context) ([]genai.Message, error) {
+	return m.store[m.session], nil
+}
+
+func (m *sessionMemory) Close() error {
+	return nil
+}
+
+func (m *sessionMemory) WithScope(scope string) genai.MemoryInterface {
+	return &sessionMemory{store: m.store, session: m.session + "/" + scope}
+}
+
+var _ = Describe("Query Controller Team Memory", func() {
+	Context("When queries with different targets share a session", func() {
+		It("should give a team the same history in single-team and multi-team queries", func() {
+			ctx := context.Background()
+			session := &sessionMemory{store: map[string][]genai.Message{}, session: "session-1"}
+			research := arkv1alpha1.QueryTarget{Type: "team", Name: "research"}
+			writing := arkv1alpha1.QueryTarget{Type: "team", Name: "writing"}
+
+			By("running a single-team query")
+			Expect(targetMemory(session, research).AddMessages(ctx, "first", []genai.Message{genai.NewUserMessage("first question")})).To(Succeed())
+
+			By("running a multi-team query in the same session")
+			for _, target := range []arkv1alpha1.QueryTarget{research, writing} {
+				history, err := targetMemory(session, target).GetMessages(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				if target.Name == "research" {
+					Expect(history).To(HaveLen(1))
+					Expect(history[0].OfUser.Content.OfString.Value).To(Equal("first question"))
+				} else {
+					Expect(history).To(BeEmpty())
+				}
+				Expect(targetMemory(session, target).AddMessages(ctx, "second", []genai.Message{genai.NewUserMessage("second question")})).To(Succeed())
+			}
+
+			By("running the single-team query again")
+			history, err := targetMemory(session, research).GetMessages(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(session.store).NotTo(HaveKey("session-1"))
+		})
+
+		It("should share the session history with agent targets", func() {
+			session := &sessionMemory{store: map[string][]genai.Message{}, session: "session-1"}
+			Expect(targetMemory(session, arkv1alpha1.QueryTarget{Type: "agent", Name: "helper"})).To(BeIdenticalTo(session))
+		})
+	})
+})
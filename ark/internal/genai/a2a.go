@@ -5,12 +5,15 @@ package genai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -39,7 +42,11 @@ func DiscoverA2AAgents(ctx context.Context, k8sClient client.Client, address str
 func DiscoverA2AAgentsWithRecorder(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, error) {
 	baseURL := strings.TrimSuffix(address, "/")
 
-	if err := validateA2AClient(address, headers, ctx, k8sClient, namespace, recorder, obj); err != nil {
+	ctx, span := telemetry.StartA2ADiscovery(ctx, address)
+	defer span.End()
+
+	if _, err := defaultA2AClientFactory.Client(ctx, k8sClient, address, headers, namespace, recorder, obj); err != nil {
+		telemetry.RecordError(span, err)
 		return nil, err
 	}
 
@@ -53,26 +60,63 @@ func DiscoverA2AAgentsWithRecorder(ctx context.Context, k8sClient client.Client,
 
 	var lastErr error
 	for _, endpoint := range endpoints {
-		req, err := createA2ARequest(ctx, endpoint.url, headers, k8sClient, namespace, recorder, obj)
-		if err != nil {
-			lastErr = err
-			continue
-		}
-
-		agentCard, err := executeA2ARequest(ctx, req, address, recorder, obj)
+		agentCard, err := fetchA2AAgentCard(ctx, endpoint.url, address, headers, k8sClient, namespace, recorder, obj)
+		telemetry.RecordDiscoveryAttempt(span, endpoint.url, endpoint.version, err)
 		if err == nil {
+			rememberA2AAgentCard(address, agentCard)
+			telemetry.RecordSuccess(span)
 			if recorder != nil && obj != nil {
 				recorder.Event(obj, corev1.EventTypeNormal, "A2ADiscoverySuccess", fmt.Sprintf("Successfully discovered agent using %s at %s", endpoint.version, endpoint.url))
 			}
 			return agentCard, nil
 		}
 
+		// A card in an unsupported protocol version is an answer, not a missing endpoint
+		var unsupported *A2AUnsupportedProtocolError
+		if errors.As(err, &unsupported) {
+			telemetry.RecordError(span, err)
+			return nil, err
+		}
+
+		// A server that still cannot be reached after retries will not answer on the other endpoint either.
+		// Error statuses move on, since some servers answer unknown paths with a server error.
+		var statusErr *A2AStatusError
+		if isA2ADiscoveryRetryable(err) && !errors.As(err, &statusErr) {
+			err = fmt.Errorf("failed to discover agent from %s: %w", endpoint.url, err)
+			telemetry.RecordError(span, err)
+			return nil, err
+		}
+
 		lastErr = err
 		logf.FromContext(ctx).Info("Failed to discover agent using endpoint, trying next", "url", endpoint.url, "version", endpoint.version, "error", err)
 	}
 
-	return nil, fmt.Errorf("failed to discover agent from all endpoints (%s, %s): %w",
+	err := fmt.Errorf("failed to discover agent from all endpoints (%s, %s): %w",
 		AgentCardPathVersion3, AgentCardPathVersion2, lastErr)
+	telemetry.RecordError(span, err)
+	return nil, err
+}
+
+// DiscoverA2AAgentsWithFailover tries each address in order and returns the agent card along with the address that served it
+func DiscoverA2AAgentsWithFailover(ctx context.Context, k8sClient client.Client, addresses []string, headers []arkv1prealpha1.Header, namespace string, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, string, error) {
+	if len(addresses) == 0 {
+		return nil, "", fmt.Errorf("no A2A server addresses to discover from")
+	}
+
+	var lastErr error
+	for i, address := range addresses {
+		if i > 0 && !AllowRetry(ctx, "A2A discovery failover to "+address) {
+			return nil, "", fmt.Errorf("retry budget exhausted during A2A discovery: %w", lastErr)
+		}
+		agentCard, err := DiscoverA2AAgentsWithRecorder(ctx, k8sClient, address, headers, namespace, recorder, obj)
+		if err == nil {
+			return agentCard, address, nil
+		}
+		lastErr = err
+		logf.FromContext(ctx).Info("A2A discovery failed for address, trying next", "address", address, "error", err)
+	}
+
+	return nil, "", lastErr
 }
 
 // ExecuteA2AAgent executes a task on an A2A agent using the official library client
@@ -80,55 +124,137 @@ func ExecuteA2AAgent(ctx context.Context, k8sClient client.Client, address strin
 	return ExecuteA2AAgentWithRecorder(ctx, k8sClient, address, headers, namespace, input, agentName, nil, nil)
 }
 
-// ExecuteA2AAgentWithRecorder executes a task on an A2A agent with optional K8s event recording
+// ExecuteA2AAgentWithRecorder executes a task on an A2A agent with optional K8s event recording.
+// The text input is checked against the agent card last discovered at the address, if any.
 func ExecuteA2AAgentWithRecorder(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace, input, agentName string, recorder record.EventRecorder, obj client.Object) (string, error) {
+	return ExecuteA2AAgentWithParts(ctx, k8sClient, address, headers, namespace, []protocol.Part{protocol.NewTextPart(input)}, agentName, discoveredA2AAgentCard(address), recorder, obj)
+}
+
+// ExecuteA2AAgentWithParts executes a task on an A2A agent with a message made of the given parts,
+// such as text alongside files or structured data. When an agent card is given, each part must
+// match one of the agent's declared input modes. If the call fails in a way that suggests the card
+// is stale, the card is discovered again and the call is retried once with the fresh card.
+func ExecuteA2AAgentWithParts(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, parts []protocol.Part, agentName string, agentCard *A2AAgentCard, recorder record.EventRecorder, obj client.Object) (string, error) {
+	response, err := executeA2AAgentWithCard(ctx, k8sClient, address, headers, namespace, parts, agentName, agentCard, recorder, obj)
+	if err == nil || !isA2ACardMismatch(err) || a2aStreamStarted(ctx) {
+		return response, err
+	}
+
+	refreshedCard, refreshErr := refreshA2AAgentCard(ctx, k8sClient, address, headers, namespace, agentName, err, recorder, obj)
+	if refreshErr != nil {
+		logf.FromContext(ctx).Info("A2A agent card refresh failed", "agent", agentName, "address", address, "error", refreshErr)
+		return "", err
+	}
+	return executeA2AAgentWithCard(ctx, k8sClient, address, headers, namespace, parts, agentName, refreshedCard, recorder, obj)
+}
+
+const a2aExecutionTimeoutKey contextKey = "a2aExecutionTimeout"
+
+// WithA2AExecutionTimeout attaches an A2AServer's execution timeout to the context.
+// A nil or non-positive timeout leaves each call bounded only by the context itself.
+func WithA2AExecutionTimeout(ctx context.Context, timeout *metav1.Duration) context.Context {
+	if timeout == nil || timeout.Duration <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, a2aExecutionTimeoutKey, timeout.Duration)
+}
+
+// withA2ACallTimeout bounds one task sent to an A2A server by the context's execution timeout, if any
+func withA2ACallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout, ok := ctx.Value(a2aExecutionTimeoutKey).(time.Duration); ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+func executeA2AAgentWithCard(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, parts []protocol.Part, agentName string, agentCard *A2AAgentCard, recorder record.EventRecorder, obj client.Object) (string, error) {
+	ctx, cancel := withA2ACallTimeout(ctx)
+	defer cancel()
+
+	if err := CheckCapabilities(agentCard, CapabilityRequest{InputModes: a2aInputModes(parts)}); err != nil {
+		if recorder != nil && obj != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "A2ACapabilityCheckFailed", fmt.Sprintf("Agent %s cannot handle the request: %v", agentName, err))
+		}
+		return "", err
+	}
+
 	rpcURL := strings.TrimSuffix(address, "/")
-	logf.FromContext(ctx).Info("calling A2A server", "url", rpcURL)
+	stream, _ := ctx.Value(a2aStreamKey).(*a2aStream)
+	streaming := stream != nil && supportsA2AStreaming(agentCard)
+	logf.FromContext(ctx).Info("calling A2A server", "url", rpcURL, "streaming", streaming)
+
+	if streaming {
+		a2aClient, err := defaultA2AClientFactory.StreamClient(ctx, k8sClient, rpcURL, headers, namespace, recorder, obj)
+		if err != nil {
+			return "", err
+		}
+		return streamA2AAgentMessage(ctx, a2aClient, parts, agentName, rpcURL, stream, recorder, obj)
+	}
 
-	// Create and configure A2A client
-	a2aClient, err := createA2AClientForExecution(ctx, k8sClient, rpcURL, headers, namespace, agentName, recorder, obj)
+	a2aClient, err := defaultA2AClientFactory.Client(ctx, k8sClient, rpcURL, headers, namespace, recorder, obj)
 	if err != nil {
 		return "", err
 	}
 
 	// Execute agent and get response
-	return executeA2AAgentMessage(ctx, a2aClient, input, agentName, rpcURL, recorder, obj)
+	return executeA2AAgentMessage(ctx, a2aClient, parts, agentName, rpcURL, recorder, obj)
 }
 
-// createA2AClientForExecution creates and configures A2A client for agent execution
-func createA2AClientForExecution(ctx context.Context, k8sClient client.Client, rpcURL string, headers []arkv1prealpha1.Header, namespace, agentName string, recorder record.EventRecorder, obj client.Object) (*a2aclient.A2AClient, error) {
-	var clientOptions []a2aclient.Option
-	if len(headers) > 0 {
-		resolvedHeaders, err := resolveA2AHeaders(ctx, k8sClient, headers, namespace)
-		if err != nil {
-			if recorder != nil && obj != nil {
-				recorder.Event(obj, corev1.EventTypeWarning, "A2AHeaderResolutionFailed", fmt.Sprintf("Failed to resolve headers for agent %s: %v", agentName, err))
-			}
-			return nil, err
+// ExecuteA2AAgentWithFailover executes a task against each address in order, moving to the next address only on connection errors.
+// It returns the response along with the address that served it.
+func ExecuteA2AAgentWithFailover(ctx context.Context, k8sClient client.Client, addresses []string, headers []arkv1prealpha1.Header, namespace string, parts []protocol.Part, agentName string, recorder record.EventRecorder, obj client.Object) (string, string, error) {
+	if len(addresses) == 0 {
+		return "", "", fmt.Errorf("no A2A server addresses for agent %s", agentName)
+	}
+
+	var lastErr error
+	for i, address := range addresses {
+		if i > 0 && !AllowRetry(ctx, "A2A failover to "+address) {
+			return "", "", fmt.Errorf("retry budget exhausted executing A2A agent %s: %w", agentName, lastErr)
+		}
+		response, err := ExecuteA2AAgentWithParts(ctx, k8sClient, address, headers, namespace, parts, agentName, discoveredA2AAgentCard(address), recorder, obj)
+		if err == nil {
+			defaultA2AAddressSelector.RecordSuccess(address)
+			return response, address, nil
+		}
+		// A cancelled caller says nothing about the address, so it is neither penalized nor failed over.
+		// A stream that already sent text keeps it, and the task is not sent again.
+		if ctx.Err() != nil || !isA2AConnectionError(err) || a2aStreamStarted(ctx) {
+			return response, address, err
 		}
+		defaultA2AAddressSelector.RecordFailure(address)
+		lastErr = err
+		logf.FromContext(ctx).Info("A2A address unreachable, failing over", "agent", agentName, "address", address, "error", err)
+	}
+
+	return "", "", lastErr
+}
 
-		httpClient := &http.Client{Timeout: 30 * time.Second}
-		clientOptions = append(clientOptions, a2aclient.WithHTTPClient(httpClient))
-		clientOptions = append(clientOptions, a2aclient.WithHTTPReqHandler(&customA2ARequestHandler{
-			headers: resolvedHeaders,
-		}))
+// isA2AConnectionError reports whether the A2A server could not be reached at all,
+// as opposed to the agent returning an error for the task
+func isA2AConnectionError(err error) bool {
+	var rejected *A2ATaskRejectedError
+	if err == nil || errors.As(err, &rejected) {
+		return false
 	}
 
-	a2aClient, err := a2aclient.NewA2AClient(rpcURL, clientOptions...)
-	if err != nil {
-		if recorder != nil && obj != nil {
-			recorder.Event(obj, corev1.EventTypeWarning, "A2AClientCreateFailed", fmt.Sprintf("Failed to create A2A client for agent %s at %s: %v", agentName, rpcURL, err))
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	errStr := strings.ToLower(err.Error())
+	for _, pattern := range []string{"connection refused", "connection reset", "no such host", "network is unreachable"} {
+		if strings.Contains(errStr, pattern) {
+			return true
 		}
-		return nil, fmt.Errorf("failed to create A2A client: %w", err)
 	}
-	return a2aClient, nil
+	return false
 }
 
 // executeA2AAgentMessage sends message to A2A agent and processes response
-func executeA2AAgentMessage(ctx context.Context, a2aClient *a2aclient.A2AClient, input, agentName, rpcURL string, recorder record.EventRecorder, obj client.Object) (string, error) {
-	message := protocol.NewMessage(protocol.MessageRoleUser, []protocol.Part{
-		protocol.NewTextPart(input),
-	})
+func executeA2AAgentMessage(ctx context.Context, a2aClient *a2aclient.A2AClient, parts []protocol.Part, agentName, rpcURL string, recorder record.EventRecorder, obj client.Object) (string, error) {
+	message := protocol.NewMessage(protocol.MessageRoleUser, parts)
 
 	blocking := true
 	params := protocol.SendMessageParams{
@@ -151,14 +277,31 @@ func executeA2AAgentMessage(ctx context.Context, a2aClient *a2aclient.A2AClient,
 		return "", fmt.Errorf("A2A server call failed: %w", err)
 	}
 
-	response, err := extractTextFromMessageResult(result)
+	recordA2ATokenUsage(ctx, a2aResultMetadata(result))
+	if task, ok := result.Result.(*protocol.Task); ok {
+		recordA2AToolCalls(ctx, task)
+		recordA2ATaskHistory(ctx, task)
+	}
+
+	response, truncated, err := extractTextFromMessageResult(result)
 	if err != nil {
+		var rejected *A2ATaskRejectedError
+		if errors.As(err, &rejected) {
+			if recorder != nil && obj != nil {
+				recorder.Event(obj, corev1.EventTypeWarning, "A2ATaskRejected", fmt.Sprintf("Agent %s rejected the task: %s", agentName, rejected.Reason))
+			}
+			return "", err
+		}
 		if recorder != nil && obj != nil {
 			recorder.Event(obj, corev1.EventTypeWarning, "A2AResponseParseError", fmt.Sprintf("Failed to parse response from agent %s: %v", agentName, err))
 		}
 		return "", err
 	}
 
+	if truncated {
+		recordA2AResponseTruncated(ctx, agentName, recorder, obj)
+	}
+
 	if recorder != nil && obj != nil {
 		recorder.Event(obj, corev1.EventTypeNormal, "A2AExecutionSuccess", fmt.Sprintf("Successfully executed agent %s, response length: %d characters", agentName, len(response)))
 	}
@@ -173,6 +316,8 @@ type customA2ARequestHandler struct {
 
 // Handle implements the HTTPReqHandler interface
 func (h *customA2ARequestHandler) Handle(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	setUserAgent(req.Header)
+
 	// Add custom headers
 	for name, value := range h.headers {
 		req.Header.Set(name, value)
@@ -185,49 +330,94 @@ func (h *customA2ARequestHandler) Handle(ctx context.Context, httpClient *http.C
 		req.Header.Set(name, value)
 	}
 
+	if err := prepareA2ACompression(req); err != nil {
+		return nil, err
+	}
+
 	// Perform the request
-	return httpClient.Do(req)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := decompressA2AResponse(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
 }
 
-// extractTextFromMessageResult extracts text from MessageResult using type-safe methods
-func extractTextFromMessageResult(result *protocol.MessageResult) (string, error) {
+// extractTextFromMessageResult extracts text from MessageResult using type-safe methods.
+// The text is limited to the configured maximum response size; truncated reports whether it was cut off.
+func extractTextFromMessageResult(result *protocol.MessageResult) (text string, truncated bool, err error) {
 	if result == nil {
-		return "", fmt.Errorf("result is nil")
+		return "", false, fmt.Errorf("result is nil")
 	}
 
+	response := newA2AResponseBuilder()
 	switch r := result.Result.(type) {
 	case *protocol.Message:
-		return extractTextFromParts(r.Parts), nil
+		response.WriteString(extractTextFromParts(r.Parts))
 	case *protocol.Task:
-		return extractTextFromTask(r)
+		if err := writeTextFromTask(response, r); err != nil {
+			return "", false, err
+		}
 	default:
-		return "", fmt.Errorf("unexpected result type: %T", result.Result)
+		return "", false, fmt.Errorf("unexpected result type: %T", result.Result)
 	}
+	return response.String(), response.Truncated(), nil
 }
 
-// extractTextFromTask extracts text from a completed or failed Task
+// extractTextFromTask extracts text from a completed, failed or rejected Task
 func extractTextFromTask(task *protocol.Task) (string, error) {
+	response := newA2AResponseBuilder()
+	if err := writeTextFromTask(response, task); err != nil {
+		return "", err
+	}
+	return response.String(), nil
+}
+
+// writeTextFromTask writes the text of a completed Task to the response, or returns the error of a failed or rejected one
+func writeTextFromTask(response *a2aResponseBuilder, task *protocol.Task) error {
 	if task.Status.State == "" {
-		return "", fmt.Errorf("task has no status state")
+		return fmt.Errorf("task has no status state")
 	}
 
 	switch task.Status.State {
 	case TaskStateCompleted:
-		// Extract all agent messages from history
-		var text strings.Builder
+		// Artifacts are the task's final output, so history is only used when they hold no content
+		start := response.Len()
+		for _, artifact := range task.Artifacts {
+			if response.Truncated() {
+				break
+			}
+			if artifactText := extractContentFromParts(artifact.Parts); artifactText != "" {
+				if response.Len() > 0 {
+					response.WriteString("\n")
+				}
+				response.WriteString(artifactText)
+			}
+		}
+		if response.Len() > start {
+			return nil
+		}
+
+		// Extract all agent messages from history, skipping reasoning, progress and tool call messages
 		for _, msg := range task.History {
-			if msg.Role == protocol.MessageRoleAgent && len(msg.Parts) > 0 {
+			if response.Truncated() {
+				break
+			}
+			if msg.Role == protocol.MessageRoleAgent && len(msg.Parts) > 0 && !isA2AReasoningMessage(msg) && !isA2AToolCallMessage(msg) {
 				msgText := extractTextFromParts(msg.Parts)
 				if msgText != "" {
-					if text.Len() > 0 {
-						text.WriteString("\n")
+					if response.Len() > 0 {
+						response.WriteString("\n")
 					}
-					text.WriteString(msgText)
+					response.WriteString(msgText)
 				}
 			}
 		}
 
-		return text.String(), nil
+		return nil
 
 	case TaskStateFailed:
 		// Extract error message from status.message
@@ -235,13 +425,77 @@ func extractTextFromTask(task *protocol.Task) (string, error) {
 		if task.Status.Message != nil && len(task.Status.Message.Parts) > 0 {
 			errorMsg = extractTextFromParts(task.Status.Message.Parts)
 		}
-		return "", fmt.Errorf("%s", errorMsg)
+		return fmt.Errorf("%s", errorMsg)
+
+	case TaskStateRejected:
+		reason := "no reason given"
+		if task.Status.Message != nil && len(task.Status.Message.Parts) > 0 {
+			reason = extractTextFromParts(task.Status.Message.Parts)
+		}
+		return &A2ATaskRejectedError{Reason: reason}
 
 	default:
-		return "", fmt.Errorf("task in state '%s' (expected %s or %s)", task.Status.State, TaskStateCompleted, TaskStateFailed)
+		return fmt.Errorf("task in state '%s' (expected %s, %s or %s)", task.Status.State, TaskStateCompleted, TaskStateFailed, TaskStateRejected)
 	}
 }
 
+// isA2AReasoningMessage reports whether the agent marked a message as reasoning rather than answer text
+func isA2AReasoningMessage(msg protocol.Message) bool {
+	kind, _ := msg.Metadata[A2AMessageKindMetadataKey].(string)
+	return kind == A2AMessageKindReasoning
+}
+
+// isA2AToolCallMessage reports whether the agent marked a message as a tool call, which is captured separately
+func isA2AToolCallMessage(msg protocol.Message) bool {
+	kind, _ := msg.Metadata[A2AMessageKindMetadataKey].(string)
+	return kind == A2AMessageKindToolCall
+}
+
+// a2aPartInputMode returns the MIME type of a message part
+func a2aPartInputMode(part protocol.Part) string {
+	var file protocol.FileUnion
+	switch p := part.(type) {
+	case protocol.DataPart, *protocol.DataPart:
+		return "application/json"
+	case protocol.FilePart:
+		file = p.File
+	case *protocol.FilePart:
+		file = p.File
+	default:
+		return "text/plain"
+	}
+
+	var mimeType *string
+	switch f := file.(type) {
+	case *protocol.FileWithBytes:
+		mimeType = f.MimeType
+	case *protocol.FileWithURI:
+		mimeType = f.MimeType
+	}
+	if mimeType == nil || *mimeType == "" {
+		return "application/octet-stream"
+	}
+	return *mimeType
+}
+
+// a2aInputModeAccepted matches a MIME type against declared modes, allowing wildcards such as image/*
+func a2aInputModeAccepted(modes []string, mode string) bool {
+	mediaType, _, _ := strings.Cut(mode, "/")
+	for _, accepted := range modes {
+		accepted = strings.ToLower(strings.TrimSpace(accepted))
+		switch {
+		case accepted == "*/*" || strings.EqualFold(accepted, mode):
+			return true
+		case strings.HasSuffix(accepted, "/*") && strings.EqualFold(strings.TrimSuffix(accepted, "/*"), mediaType):
+			return true
+		case accepted == "text" && strings.EqualFold(mode, "text/plain"):
+			// Older agent cards declare plain "text" rather than a MIME type
+			return true
+		}
+	}
+	return false
+}
+
 // extractTextFromParts extracts text from message parts in a type-safe way
 func extractTextFromParts(parts []protocol.Part) string {
 	var text strings.Builder
@@ -255,29 +509,75 @@ func extractTextFromParts(parts []protocol.Part) string {
 	return text.String()
 }
 
-// validateA2AClient validates A2A client creation
-func validateA2AClient(address string, headers []arkv1prealpha1.Header, ctx context.Context, k8sClient client.Client, namespace string, recorder record.EventRecorder, obj client.Object) error {
-	var clientOptions []a2aclient.Option
-	clientOptions = append(clientOptions, a2aclient.WithTimeout(30*time.Second))
-
-	if len(headers) > 0 {
-		resolvedHeaders, err := resolveA2AHeaders(ctx, k8sClient, headers, namespace)
-		if err != nil {
-			return err
+// extractContentFromParts extracts text from parts like extractTextFromParts, and summarizes
+// data and file parts on their own lines so non-text output is not lost
+func extractContentFromParts(parts []protocol.Part) string {
+	var lines []string
+	var text strings.Builder
+	for _, part := range parts {
+		var summary string
+		switch p := part.(type) {
+		case protocol.TextPart:
+			text.WriteString(p.Text)
+			continue
+		case *protocol.TextPart:
+			text.WriteString(p.Text)
+			continue
+		case protocol.DataPart:
+			summary = summarizeA2AData(p.Data)
+		case *protocol.DataPart:
+			summary = summarizeA2AData(p.Data)
+		case protocol.FilePart:
+			summary = summarizeA2AFile(p.File)
+		case *protocol.FilePart:
+			summary = summarizeA2AFile(p.File)
+		}
+		if text.Len() > 0 {
+			lines = append(lines, text.String())
+			text.Reset()
+		}
+		if summary != "" {
+			lines = append(lines, summary)
 		}
-		clientOptions = append(clientOptions, a2aclient.WithHTTPReqHandler(&customA2ARequestHandler{
-			headers: resolvedHeaders,
-		}))
 	}
+	if text.Len() > 0 {
+		lines = append(lines, text.String())
+	}
+	return strings.Join(lines, "\n")
+}
 
-	_, err := a2aclient.NewA2AClient(address, clientOptions...)
+// summarizeA2AData renders a data part as JSON
+func summarizeA2AData(data any) string {
+	encoded, err := json.Marshal(data)
 	if err != nil {
-		if recorder != nil && obj != nil {
-			recorder.Event(obj, corev1.EventTypeWarning, "A2AClientCreateFailed", fmt.Sprintf("Failed to create A2A client for %s: %v", address, err))
-		}
-		return fmt.Errorf("failed to create A2A client: %w", err)
+		return fmt.Sprintf("[data: %v]", data)
+	}
+	return string(encoded)
+}
+
+// summarizeA2AFile describes a file part by name, MIME type and location or size, without its content
+func summarizeA2AFile(file protocol.FileUnion) string {
+	var name, mimeType *string
+	var location string
+	switch f := file.(type) {
+	case *protocol.FileWithURI:
+		name, mimeType, location = f.Name, f.MimeType, f.URI
+	case *protocol.FileWithBytes:
+		name, mimeType = f.Name, f.MimeType
+		location = fmt.Sprintf("%d bytes", len(strings.TrimRight(f.Bytes, "="))*3/4)
+	default:
+		return ""
+	}
+
+	details := []string{}
+	if name != nil && *name != "" {
+		details = append(details, *name)
+	}
+	if mimeType != nil && *mimeType != "" {
+		details = append(details, "("+*mimeType+")")
 	}
-	return nil
+	details = append(details, location)
+	return "[file: " + strings.Join(details, " ") + "]"
 }
 
 // createA2ARequest creates and configures HTTP request for A2A discovery
@@ -289,6 +589,7 @@ func createA2ARequest(ctx context.Context, agentCardURL string, headers []arkv1p
 		}
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	setUserAgent(req.Header)
 
 	// Add resolved headers if specified
 	if len(headers) > 0 {
@@ -310,13 +611,14 @@ func createA2ARequest(ctx context.Context, agentCardURL string, headers []arkv1p
 	for name, value := range headerMap {
 		req.Header.Set(name, value)
 	}
+	req.Header.Set("Accept-Encoding", "gzip")
 
 	return req, nil
 }
 
 // executeA2ARequest executes HTTP request and parses agent card response
-func executeA2ARequest(ctx context.Context, req *http.Request, address string, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, error) {
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+func executeA2ARequest(ctx context.Context, req *http.Request, address string, timeout time.Duration, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, error) {
+	httpClient := &http.Client{Timeout: timeout}
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		if recorder != nil && obj != nil {
@@ -330,11 +632,15 @@ func executeA2ARequest(ctx context.Context, req *http.Request, address string, r
 		}
 	}()
 
+	if err := decompressA2AResponse(resp); err != nil {
+		return nil, err
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		if recorder != nil && obj != nil {
 			recorder.Event(obj, corev1.EventTypeWarning, "A2ABadResponse", fmt.Sprintf("A2A server %s returned HTTP status %d", address, resp.StatusCode))
 		}
-		return nil, fmt.Errorf("A2A server returned status %d", resp.StatusCode)
+		return nil, &A2AStatusError{StatusCode: resp.StatusCode, Method: "GET " + req.URL.Path}
 	}
 
 	var agentCard A2AAgentCard
@@ -345,6 +651,13 @@ func executeA2ARequest(ctx context.Context, req *http.Request, address string, r
 		return nil, fmt.Errorf("failed to parse agent card: %w", err)
 	}
 
+	if err := checkA2AProtocolVersion(&agentCard); err != nil {
+		if recorder != nil && obj != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "A2AUnsupportedProtocol", fmt.Sprintf("A2A server %s: %v", address, err))
+		}
+		return nil, err
+	}
+
 	if recorder != nil && obj != nil {
 		recorder.Event(obj, corev1.EventTypeNormal, "A2ADiscoverySuccess", fmt.Sprintf("Successfully discovered agent %s from %s", agentCard.Name, address))
 	}
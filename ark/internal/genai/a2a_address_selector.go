@@ -0,0 +1,103 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// A2A address selection policies
+const (
+	A2AAddressSelectionOrdered    = "ordered"
+	A2AAddressSelectionRoundRobin = "round-robin"
+	A2AAddressSelectionRandom     = "random"
+)
+
+const (
+	// a2aUnhealthyFailureThreshold is the number of consecutive connection failures before an address is skipped
+	a2aUnhealthyFailureThreshold = 3
+	// a2aUnhealthyCooldown is how long an unhealthy address is skipped before it is tried again
+	a2aUnhealthyCooldown = 30 * time.Second
+)
+
+type a2aAddressHealth struct {
+	failures  int
+	skipUntil time.Time
+}
+
+// A2AAddressSelector orders A2A server addresses according to a selection policy,
+// moving addresses with repeated connection failures to the back until their cooldown expires
+type A2AAddressSelector struct {
+	mu      sync.Mutex
+	cursors map[string]int
+	health  map[string]*a2aAddressHealth
+	now     func() time.Time
+}
+
+// NewA2AAddressSelector creates an address selector with no recorded health
+func NewA2AAddressSelector() *A2AAddressSelector {
+	return &A2AAddressSelector{
+		cursors: make(map[string]int),
+		health:  make(map[string]*a2aAddressHealth),
+		now:     time.Now,
+	}
+}
+
+var defaultA2AAddressSelector = NewA2AAddressSelector()
+
+// Order returns the addresses to try for the given server key. Healthy addresses come first in
+// policy order, followed by addresses that are currently being skipped as a last resort.
+func (s *A2AAddressSelector) Order(serverKey, policy string, addresses []string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var healthy, unhealthy []string
+	for _, address := range addresses {
+		if h, ok := s.health[address]; ok && now.Before(h.skipUntil) {
+			unhealthy = append(unhealthy, address)
+			continue
+		}
+		healthy = append(healthy, address)
+	}
+
+	switch policy {
+	case A2AAddressSelectionRoundRobin:
+		if len(healthy) > 1 {
+			start := s.cursors[serverKey] % len(healthy)
+			s.cursors[serverKey] = start + 1
+			healthy = append(append([]string{}, healthy[start:]...), healthy[:start]...)
+		}
+	case A2AAddressSelectionRandom:
+		rand.Shuffle(len(healthy), func(i, j int) {
+			healthy[i], healthy[j] = healthy[j], healthy[i]
+		})
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// RecordSuccess clears the failure count of an address
+func (s *A2AAddressSelector) RecordSuccess(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.health, address)
+}
+
+// RecordFailure counts a connection failure and starts the cooldown once the threshold is reached
+func (s *A2AAddressSelector) RecordFailure(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.health[address]
+	if !ok {
+		h = &a2aAddressHealth{}
+		s.health[address] = h
+	}
+	h.failures++
+	if h.failures >= a2aUnhealthyFailureThreshold {
+		h.skipUntil = s.now().Add(a2aUnhealthyCooldown)
+	}
+}
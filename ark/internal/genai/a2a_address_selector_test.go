@@ -0,0 +1,53 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestA2AAddressSelectorOrder(t *testing.T) {
+	addresses := []string{"http://a", "http://b", "http://c"}
+
+	t.Run("ordered keeps the given order", func(t *testing.T) {
+		s := NewA2AAddressSelector()
+		assert.Equal(t, addresses, s.Order("ns/server", A2AAddressSelectionOrdered, addresses))
+		assert.Equal(t, addresses, s.Order("ns/server", "", addresses))
+	})
+
+	t.Run("round-robin rotates per server", func(t *testing.T) {
+		s := NewA2AAddressSelector()
+		assert.Equal(t, []string{"http://a", "http://b", "http://c"}, s.Order("ns/server", A2AAddressSelectionRoundRobin, addresses))
+		assert.Equal(t, []string{"http://b", "http://c", "http://a"}, s.Order("ns/server", A2AAddressSelectionRoundRobin, addresses))
+		assert.Equal(t, []string{"http://a", "http://b", "http://c"}, s.Order("ns/other", A2AAddressSelectionRoundRobin, addresses))
+	})
+
+	t.Run("random returns every address", func(t *testing.T) {
+		s := NewA2AAddressSelector()
+		assert.ElementsMatch(t, addresses, s.Order("ns/server", A2AAddressSelectionRandom, addresses))
+	})
+
+	t.Run("unhealthy addresses move to the back until cooldown expires", func(t *testing.T) {
+		now := time.Now()
+		s := NewA2AAddressSelector()
+		s.now = func() time.Time { return now }
+
+		for i := 0; i < a2aUnhealthyFailureThreshold-1; i++ {
+			s.RecordFailure("http://a")
+		}
+		assert.Equal(t, addresses, s.Order("ns/server", A2AAddressSelectionOrdered, addresses))
+
+		s.RecordFailure("http://a")
+		assert.Equal(t, []string{"http://b", "http://c", "http://a"}, s.Order("ns/server", A2AAddressSelectionOrdered, addresses))
+
+		now = now.Add(a2aUnhealthyCooldown)
+		assert.Equal(t, addresses, s.Order("ns/server", A2AAddressSelectionOrdered, addresses))
+
+		s.RecordSuccess("http://a")
+		s.RecordFailure("http://a")
+		assert.Equal(t, addresses, s.Order("ns/server", A2AAddressSelectionOrdered, addresses))
+	})
+}
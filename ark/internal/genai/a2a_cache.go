@@ -0,0 +1,83 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultA2ACacheIdleTimeout is how long a cached A2A client or agent card is kept without being used
+const DefaultA2ACacheIdleTimeout = time.Hour
+
+// a2aIdleCache is a map whose entries are dropped once they have not been used for the idle timeout.
+// Expired entries are swept whenever an entry is stored, so the cache only holds the A2A servers
+// that are still being queried rather than every address seen since the controller started.
+type a2aIdleCache[V any] struct {
+	idleTimeout time.Duration
+	onEvict     func(V)
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*a2aIdleCacheEntry[V]
+}
+
+type a2aIdleCacheEntry[V any] struct {
+	value    V
+	lastUsed time.Time
+}
+
+func newA2AIdleCache[V any](idleTimeout time.Duration, onEvict func(V)) *a2aIdleCache[V] {
+	return &a2aIdleCache[V]{
+		idleTimeout: idleTimeout,
+		onEvict:     onEvict,
+		now:         time.Now,
+		entries:     make(map[string]*a2aIdleCacheEntry[V]),
+	}
+}
+
+// get returns the cached value and marks it as used
+func (c *a2aIdleCache[V]) get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || c.expired(entry) {
+		var zero V
+		return zero, false
+	}
+	entry.lastUsed = c.now()
+	return entry.value, true
+}
+
+// put stores the value, evicting the value it replaces and any entries that have gone idle
+func (c *a2aIdleCache[V]) put(key string, value V) {
+	c.mu.Lock()
+	var evicted []V
+	if previous, ok := c.entries[key]; ok {
+		evicted = append(evicted, previous.value)
+	}
+	c.entries[key] = &a2aIdleCacheEntry[V]{value: value, lastUsed: c.now()}
+	for k, entry := range c.entries {
+		if c.expired(entry) {
+			evicted = append(evicted, entry.value)
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.onEvict != nil {
+		for _, value := range evicted {
+			c.onEvict(value)
+		}
+	}
+}
+
+func (c *a2aIdleCache[V]) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+func (c *a2aIdleCache[V]) expired(entry *a2aIdleCacheEntry[V]) bool {
+	return c.idleTimeout > 0 && c.now().Sub(entry.lastUsed) > c.idleTimeout
+}
@@ -0,0 +1,36 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestA2AIdleCacheEvictsIdleEntries(t *testing.T) {
+	now := time.Now()
+	var evicted []string
+	cache := newA2AIdleCache(time.Hour, func(v string) { evicted = append(evicted, v) })
+	cache.now = func() time.Time { return now }
+
+	cache.put("a", "server a")
+	cache.put("b", "server b")
+
+	now = now.Add(45 * time.Minute)
+	_, ok := cache.get("a")
+	assert.True(t, ok, "using an entry keeps it cached")
+
+	now = now.Add(30 * time.Minute)
+	_, ok = cache.get("b")
+	assert.False(t, ok, "entries unused for the idle timeout are not returned")
+
+	cache.put("c", "server c")
+	assert.Equal(t, []string{"server b"}, evicted, "idle entries are evicted when a new entry is stored")
+	assert.Equal(t, 2, cache.len())
+
+	cache.put("a", "server a rotated")
+	assert.Equal(t, []string{"server b", "server a"}, evicted, "replaced entries are evicted")
+	assert.Equal(t, 2, cache.len())
+}
@@ -0,0 +1,66 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+// CapabilityRequest describes what a call needs from an A2A agent. Empty fields are not checked.
+type CapabilityRequest struct {
+	Streaming  bool
+	Skill      string
+	InputModes []string
+}
+
+// CheckCapabilities reports the first capability the agent card does not advertise.
+// A nil card passes, since there is nothing to check against.
+func CheckCapabilities(card *A2AAgentCard, req CapabilityRequest) error {
+	if card == nil {
+		return nil
+	}
+
+	if req.Streaming && !supportsA2AStreaming(card) {
+		return &A2ACapabilityError{Message: fmt.Sprintf("agent %s does not advertise streaming", card.Name)}
+	}
+
+	if req.Skill != "" && !slices.ContainsFunc(card.Skills, func(skill A2ASkill) bool { return skill.ID == req.Skill }) {
+		return &A2ACapabilityError{Message: fmt.Sprintf("agent %s does not advertise skill %s", card.Name, req.Skill)}
+	}
+
+	// Agents that declare no input modes accept anything
+	if len(card.DefaultInputModes) > 0 {
+		for _, mode := range req.InputModes {
+			if !a2aInputModeAccepted(card.DefaultInputModes, mode) {
+				return &A2ACapabilityError{Message: fmt.Sprintf("agent %s does not accept %s input (accepts %s)", card.Name, mode, strings.Join(card.DefaultInputModes, ", "))}
+			}
+		}
+	}
+	return nil
+}
+
+// a2aInputModes returns the MIME types of the message parts
+func a2aInputModes(parts []protocol.Part) []string {
+	modes := make([]string, 0, len(parts))
+	for _, part := range parts {
+		modes = append(modes, a2aPartInputMode(part))
+	}
+	return modes
+}
+
+// discoveredA2AAgentCards holds the last agent card discovered at each address, so execution can
+// check capabilities without fetching the card again. Cards of servers no longer queried are dropped.
+var discoveredA2AAgentCards = newA2AIdleCache[*A2AAgentCard](DefaultA2ACacheIdleTimeout, nil)
+
+func rememberA2AAgentCard(address string, card *A2AAgentCard) {
+	discoveredA2AAgentCards.put(strings.TrimSuffix(address, "/"), card)
+}
+
+func discoveredA2AAgentCard(address string) *A2AAgentCard {
+	card, _ := discoveredA2AAgentCards.get(strings.TrimSuffix(address, "/"))
+	return card
+}
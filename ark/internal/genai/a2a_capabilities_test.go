@@ -0,0 +1,55 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+func TestCheckCapabilities(t *testing.T) {
+	streaming := true
+	card := &A2AAgentCard{
+		Name:              "weather",
+		DefaultInputModes: []string{"text", "image/*"},
+		Skills:            []A2ASkill{{ID: "forecast"}},
+	}
+	streamingCard := &A2AAgentCard{Name: "weather"}
+	streamingCard.Capabilities.Streaming = &streaming
+
+	assert.NoError(t, CheckCapabilities(nil, CapabilityRequest{Streaming: true, Skill: "any"}))
+	assert.NoError(t, CheckCapabilities(&A2AAgentCard{}, CapabilityRequest{InputModes: []string{"application/json"}}))
+	assert.NoError(t, CheckCapabilities(card, CapabilityRequest{Skill: "forecast", InputModes: a2aInputModes([]protocol.Part{
+		protocol.NewTextPart("describe this"),
+		protocol.NewFilePartWithURI("cat.png", "image/png", "https://example.com/cat.png"),
+	})}))
+	assert.NoError(t, CheckCapabilities(streamingCard, CapabilityRequest{Streaming: true}))
+
+	assert.EqualError(t, CheckCapabilities(card, CapabilityRequest{Streaming: true}), "agent weather does not advertise streaming")
+	assert.EqualError(t, CheckCapabilities(card, CapabilityRequest{Skill: "translate"}), "agent weather does not advertise skill translate")
+	assert.EqualError(t, CheckCapabilities(card, CapabilityRequest{InputModes: a2aInputModes([]protocol.Part{protocol.NewDataPart(1)})}),
+		"agent weather does not accept application/json input (accepts text, image/*)")
+}
+
+func TestExecuteA2AAgentChecksDiscoveredCard(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			called = true
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	rememberA2AAgentCard(srv.URL+"/", &A2AAgentCard{Name: "images", DefaultInputModes: []string{"image/png"}})
+
+	_, err := ExecuteA2AAgent(context.Background(), nil, srv.URL, nil, "default", "hello", "images")
+	require.EqualError(t, err, "agent images does not accept text/plain input (accepts image/png)")
+	assert.False(t, called)
+}
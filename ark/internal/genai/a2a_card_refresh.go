@@ -0,0 +1,62 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+// isA2ACardMismatch reports whether an execution error suggests the agent card used for the call is
+// out of date: the card no longer matches the request, or the server does not know the method or path
+func isA2ACardMismatch(err error) bool {
+	var capabilityErr *A2ACapabilityError
+	if errors.As(err, &capabilityErr) {
+		return true
+	}
+
+	var statusErr *A2AStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == 404 || statusErr.StatusCode == 405
+	}
+
+	var rpcErr *A2AJSONRPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Code == -32601
+	}
+
+	// The A2A client only reports these as text
+	errStr := err.Error()
+	for _, pattern := range []string{"jsonrpc error -32601", "unexpected http status 404", "unexpected http status 405"} {
+		if strings.Contains(errStr, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshA2AAgentCard discovers the agent card again after a call failed with cause. The refresh
+// takes a retry from the context's budget, and the fresh card replaces the remembered one.
+func refreshA2AAgentCard(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace, agentName string, cause error, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, error) {
+	if !AllowRetry(ctx, "A2A agent card refresh for "+agentName) {
+		return nil, fmt.Errorf("retry budget exhausted refreshing agent card for %s", agentName)
+	}
+
+	agentCard, err := DiscoverA2AAgentsWithRecorder(ctx, k8sClient, address, headers, namespace, recorder, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if recorder != nil && obj != nil {
+		recorder.Event(obj, corev1.EventTypeNormal, "A2AAgentCardRefreshed", fmt.Sprintf("Refreshed agent card for %s after: %v, retrying once", agentName, cause))
+	}
+	return agentCard, nil
+}
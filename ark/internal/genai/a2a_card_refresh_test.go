@@ -0,0 +1,110 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+func TestIsA2ACardMismatch(t *testing.T) {
+	assert.True(t, isA2ACardMismatch(&A2ACapabilityError{Message: "agent a does not advertise skill b"}))
+	assert.True(t, isA2ACardMismatch(fmt.Errorf("call: %w", &A2AStatusError{StatusCode: 404, Method: "message/send"})))
+	assert.True(t, isA2ACardMismatch(&A2AJSONRPCError{Code: -32601, Message: "Method not found"}))
+	assert.True(t, isA2ACardMismatch(errors.New("A2A server call failed: a2aClient.SendMessage: jsonrpc error -32601: Method not found")))
+
+	assert.False(t, isA2ACardMismatch(&A2AStatusError{StatusCode: 500, Method: "message/send"}))
+	assert.False(t, isA2ACardMismatch(&A2ATaskRejectedError{Reason: "no"}))
+	assert.False(t, isA2ACardMismatch(errors.New("connection refused")))
+}
+
+func TestExecuteA2AAgentRefreshesStaleCard(t *testing.T) {
+	var discoveries, calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			discoveries.Add(1)
+			_, _ = fmt.Fprint(w, `{"name":"images","defaultInputModes":["text/plain","image/png"]}`)
+			return
+		}
+		calls.Add(1)
+		_, _ = fmt.Fprint(w, `{"jsonrpc":"2.0","id":"1","result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"done"}]}}`)
+	}))
+	defer srv.Close()
+
+	recorder := record.NewFakeRecorder(10)
+	stale := &A2AAgentCard{Name: "images", DefaultInputModes: []string{"image/png"}}
+	rememberA2AAgentCard(srv.URL, stale)
+
+	response, err := ExecuteA2AAgentWithRecorder(context.Background(), nil, srv.URL, nil, "default", "hello", "images", recorder, &arkv1prealpha1.A2AServer{})
+	require.NoError(t, err)
+	assert.Equal(t, "done", response)
+	assert.Equal(t, int32(1), discoveries.Load())
+	assert.Equal(t, int32(1), calls.Load())
+	var reasons []string
+	for len(recorder.Events) > 0 {
+		reasons = append(reasons, strings.Fields(<-recorder.Events)[1])
+	}
+	assert.Contains(t, reasons, "A2AAgentCardRefreshed")
+	assert.NotSame(t, stale, discoveredA2AAgentCard(srv.URL))
+}
+
+func TestExecuteA2AAgentRefreshesCardOnce(t *testing.T) {
+	var discoveries, calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			discoveries.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, `{"name":"moved"}`)
+			return
+		}
+		calls.Add(1)
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	_, err := ExecuteA2AAgentWithParts(context.Background(), nil, srv.URL, nil, "default", nil, "moved", nil, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), discoveries.Load())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestA2AAgentRecordsCardRefreshOnServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = fmt.Fprint(w, `{"name":"images","defaultInputModes":["text/plain","image/png"]}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"jsonrpc":"2.0","id":"1","result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"done"}]}}`)
+	}))
+	defer srv.Close()
+	rememberA2AAgentCard(srv.URL, &A2AAgentCard{Name: "images", DefaultInputModes: []string{"image/png"}})
+
+	events := record.NewFakeRecorder(20)
+	recorder := NewTokenUsageCollector(NewQueryRecorder(&arkv1alpha1.Query{ObjectMeta: metav1.ObjectMeta{Name: "q", Namespace: "default"}}, events))
+	agent := newTestA2AAgent(t, recorder, srv.URL)
+
+	_, err := agent.Execute(context.Background(), NewUserMessage("hello"), nil, nil, nil)
+	require.NoError(t, err)
+
+	var reasons []string
+	for len(events.Events) > 0 {
+		reasons = append(reasons, strings.Fields(<-events.Events)[1])
+	}
+	assert.Contains(t, reasons, "A2AAgentCardRefreshed")
+}
@@ -0,0 +1,143 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	a2aclient "trpc.group/trpc-go/trpc-a2a-go/client"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+// DefaultA2AClientTimeout bounds how long clients built by the default factory wait for the response
+// headers of a streamed A2A response
+const DefaultA2AClientTimeout = 30 * time.Second
+
+var defaultA2AClientFactory = NewA2AClientFactory(DefaultA2AClientTimeout)
+
+// A2AClientFactory builds A2A clients with one set of timeout, header and request handling options.
+// Blocking calls have no client timeout, since a blocking message/send answers only once the task is done;
+// they are bounded by the caller's context.
+// Clients are cached per address and reused while the resolved headers stay the same, so rotated
+// credentials replace the cached client rather than adding to the cache. Clients unused for
+// DefaultA2ACacheIdleTimeout are dropped and their idle connections closed.
+type A2AClientFactory struct {
+	timeout time.Duration
+
+	mu      sync.Mutex
+	clients *a2aIdleCache[cachedA2AClient]
+}
+
+type cachedA2AClient struct {
+	headerHash string
+	client     *a2aclient.A2AClient
+	httpClient *http.Client
+}
+
+// NewA2AClientFactory creates a factory whose streaming clients wait at most timeout for response headers
+func NewA2AClientFactory(timeout time.Duration) *A2AClientFactory {
+	return &A2AClientFactory{
+		timeout: timeout,
+		clients: newA2AIdleCache(DefaultA2ACacheIdleTimeout, closeCachedA2AClient),
+	}
+}
+
+// Client resolves the headers and returns a client for the address, reusing a cached client when possible
+func (f *A2AClientFactory) Client(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, recorder record.EventRecorder, obj client.Object) (*a2aclient.A2AClient, error) {
+	return f.client(ctx, k8sClient, address, headers, namespace, false, recorder, obj)
+}
+
+// StreamClient is like Client, but the client's timeout only covers waiting for the response headers.
+// A streamed response lasts as long as the task runs, so reading it is bounded by the request context instead.
+func (f *A2AClientFactory) StreamClient(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, recorder record.EventRecorder, obj client.Object) (*a2aclient.A2AClient, error) {
+	return f.client(ctx, k8sClient, address, headers, namespace, true, recorder, obj)
+}
+
+func (f *A2AClientFactory) client(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace string, streaming bool, recorder record.EventRecorder, obj client.Object) (*a2aclient.A2AClient, error) {
+	var resolvedHeaders map[string]string
+	if len(headers) > 0 {
+		var err error
+		resolvedHeaders, err = resolveA2AHeaders(ctx, k8sClient, headers, namespace)
+		if err != nil {
+			if recorder != nil && obj != nil {
+				recorder.Event(obj, corev1.EventTypeWarning, "A2AHeaderResolutionFailed", fmt.Sprintf("Failed to resolve headers for A2A server %s: %v", address, err))
+			}
+			return nil, err
+		}
+	}
+
+	headerHash := hashA2AHeaders(resolvedHeaders)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cacheKey := address
+	if streaming {
+		cacheKey = "stream:" + address
+	}
+	if cached, ok := f.clients.get(cacheKey); ok && cached.headerHash == headerHash {
+		return cached.client, nil
+	}
+
+	// The custom handler is always installed so that trace context and gzip handling apply even without custom headers
+	httpClient := f.httpClient(streaming)
+	a2aClient, err := a2aclient.NewA2AClient(address,
+		a2aclient.WithHTTPClient(httpClient),
+		a2aclient.WithHTTPReqHandler(&customA2ARequestHandler{
+			headers: resolvedHeaders,
+		}),
+	)
+	if err != nil {
+		if recorder != nil && obj != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "A2AClientCreateFailed", fmt.Sprintf("Failed to create A2A client for %s: %v", address, err))
+		}
+		return nil, fmt.Errorf("failed to create A2A client: %w", err)
+	}
+
+	f.clients.put(cacheKey, cachedA2AClient{headerHash: headerHash, client: a2aClient, httpClient: httpClient})
+	return a2aClient, nil
+}
+
+// closeCachedA2AClient closes the idle connections of a client dropped from the cache. Blocking clients
+// share the default transport, whose connections other clients still use, so only streaming clients
+// with their own transport are closed.
+func closeCachedA2AClient(cached cachedA2AClient) {
+	if cached.httpClient != nil && cached.httpClient.Transport != nil {
+		cached.httpClient.CloseIdleConnections()
+	}
+}
+
+func (f *A2AClientFactory) httpClient(streaming bool) *http.Client {
+	if !streaming {
+		return &http.Client{}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = f.timeout
+	return &http.Client{Transport: transport}
+}
+
+// hashA2AHeaders returns a stable hash of the resolved headers so credentials are not kept as cache keys
+func hashA2AHeaders(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00", name, headers[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,77 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+func authHeader(value string) []arkv1prealpha1.Header {
+	return []arkv1prealpha1.Header{{Name: "Authorization", Value: arkv1alpha1.HeaderValue{Value: value}}}
+}
+
+func TestA2AClientFactory(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization"))
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"ok"}]}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	factory := NewA2AClientFactory(time.Second)
+
+	first, err := factory.Client(ctx, nil, srv.URL, authHeader("Bearer a"), "default", nil, nil)
+	require.NoError(t, err)
+	second, err := factory.Client(ctx, nil, srv.URL, authHeader("Bearer a"), "default", nil, nil)
+	require.NoError(t, err)
+	assert.Same(t, first, second, "clients are reused while headers are unchanged")
+
+	rotated, err := factory.Client(ctx, nil, srv.URL, authHeader("Bearer b"), "default", nil, nil)
+	require.NoError(t, err)
+	assert.NotSame(t, first, rotated, "changed headers build a new client")
+	assert.Equal(t, 1, factory.clients.len(), "the rotated client replaces the cached one")
+
+	_, err = executeA2AAgentMessage(ctx, rotated, []protocol.Part{protocol.NewTextPart("hi")}, "agent", srv.URL, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer b"}, received)
+}
+
+func TestA2AClientFactoryBlockingCallOutlivesTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		time.Sleep(300 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"slow"}]}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	// A blocking message/send answers when the task is done, so only the caller's context bounds it
+	a2aClient, err := NewA2AClientFactory(100*time.Millisecond).Client(context.Background(), nil, srv.URL, nil, "default", nil, nil)
+	require.NoError(t, err)
+	response, err := executeA2AAgentMessage(context.Background(), a2aClient, []protocol.Part{protocol.NewTextPart("hi")}, "agent", srv.URL, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "slow", response)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = executeA2AAgentMessage(ctx, a2aClient, []protocol.Part{protocol.NewTextPart("hi")}, "agent", srv.URL, nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// a2aGzipRequestThreshold is the minimum request body size that is compressed when the server accepts gzip
+const a2aGzipRequestThreshold = 64 * 1024
+
+// a2aGzipHosts tracks hosts that advertised gzip support for request bodies via the Accept-Encoding response header (RFC 7694)
+var a2aGzipHosts sync.Map
+
+// prepareA2ACompression asks for gzip responses and compresses large request bodies for hosts known to accept gzip.
+// Setting Accept-Encoding explicitly disables Go's transparent decompression, so responses must go through decompressA2AResponse.
+func prepareA2ACompression(req *http.Request) error {
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if req.Body == nil || req.ContentLength < a2aGzipRequestThreshold {
+		return nil
+	}
+	if _, ok := a2aGzipHosts.Load(req.URL.Host); !ok {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	_ = req.Body.Close()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(body); err != nil {
+		return fmt.Errorf("failed to compress request body: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress request body: %w", err)
+	}
+
+	compressed := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// decompressA2AResponse transparently decompresses gzip response bodies and remembers whether the host accepts gzip requests
+func decompressA2AResponse(resp *http.Response) error {
+	if resp.Request != nil && strings.Contains(resp.Header.Get("Accept-Encoding"), "gzip") {
+		a2aGzipHosts.Store(resp.Request.URL.Host, true)
+	}
+
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to decompress A2A response: %w", err)
+	}
+	resp.Body = &gzipResponseBody{Reader: gz, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipResponseBody closes both the gzip reader and the underlying response body
+type gzipResponseBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipResponseBody) Close() error {
+	_ = b.Reader.Close()
+	return b.body.Close()
+}
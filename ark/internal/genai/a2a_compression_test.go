@@ -0,0 +1,53 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestA2AGzipCompression(t *testing.T) {
+	var requestEncodings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestEncodings = append(requestEncodings, r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+		body := io.Reader(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = gz
+		}
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(body).Decode(&req))
+
+		w.Header().Set("Accept-Encoding", "gzip")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"status":"ok"}}`))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	largeParams := map[string]string{"data": strings.Repeat("x", a2aGzipRequestThreshold)}
+
+	var result map[string]string
+	require.NoError(t, CallA2AMethod(context.Background(), nil, server.URL, nil, "default", "custom/method", largeParams, &result))
+	assert.Equal(t, "ok", result["status"])
+
+	require.NoError(t, CallA2AMethod(context.Background(), nil, server.URL, nil, "default", "custom/method", largeParams, &result))
+	require.NoError(t, CallA2AMethod(context.Background(), nil, server.URL, nil, "default", "custom/method", map[string]string{"data": "small"}, &result))
+
+	// The first request is sent uncompressed until the server advertises gzip support
+	assert.Equal(t, []string{"", "gzip", ""}, requestEncodings)
+}
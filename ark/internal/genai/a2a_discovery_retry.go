@@ -0,0 +1,107 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+const (
+	// DefaultA2ADiscoveryRetries is how often an agent card request is retried after a transient failure
+	DefaultA2ADiscoveryRetries = 3
+	// DefaultA2ADiscoveryTimeout bounds each agent card request when the A2AServer sets no discovery timeout
+	DefaultA2ADiscoveryTimeout = 30 * time.Second
+
+	a2aDiscoveryMaxBackoff = 10 * time.Second
+	// a2aDiscoveryDeadlineFactor bounds all attempts for one agent card, as a multiple of the discovery timeout
+	a2aDiscoveryDeadlineFactor = 2
+)
+
+const a2aDiscoveryTimeoutKey contextKey = "a2aDiscoveryTimeout"
+
+var (
+	a2aDiscoveryRetries = DefaultA2ADiscoveryRetries
+	// a2aDiscoveryBaseBackoff is the wait before the first retry, doubling for each further retry
+	a2aDiscoveryBaseBackoff = time.Second
+)
+
+// SetA2ADiscoveryRetries sets how often an agent card request is retried after a connection failure,
+// timeout or server error. Zero or less disables retries.
+func SetA2ADiscoveryRetries(retries int) {
+	a2aDiscoveryRetries = retries
+}
+
+// WithA2ADiscoveryTimeout attaches an A2AServer's discovery timeout to the context.
+// A nil or non-positive timeout falls back to the default.
+func WithA2ADiscoveryTimeout(ctx context.Context, timeout *metav1.Duration) context.Context {
+	if timeout == nil || timeout.Duration <= 0 {
+		return context.WithValue(ctx, a2aDiscoveryTimeoutKey, DefaultA2ADiscoveryTimeout)
+	}
+	return context.WithValue(ctx, a2aDiscoveryTimeoutKey, timeout.Duration)
+}
+
+func a2aDiscoveryTimeoutFrom(ctx context.Context) time.Duration {
+	if timeout, ok := ctx.Value(a2aDiscoveryTimeoutKey).(time.Duration); ok {
+		return timeout
+	}
+	return DefaultA2ADiscoveryTimeout
+}
+
+// isA2ADiscoveryRetryable reports whether a failed agent card request may succeed when sent again.
+// Other failures, such as a missing endpoint or a malformed card, are answers and fail fast.
+func isA2ADiscoveryRetryable(err error) bool {
+	var statusErr *A2AStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return isA2AConnectionError(err)
+}
+
+// fetchA2AAgentCard requests the agent card at cardURL, retrying transient failures with exponential backoff.
+// Each retry takes one from the context's retry budget and records an A2ADiscoveryRetry event.
+// All attempts together are bounded by twice the discovery timeout, so an unreachable address fails over quickly.
+func fetchA2AAgentCard(ctx context.Context, cardURL, address string, headers []arkv1prealpha1.Header, k8sClient client.Client, namespace string, recorder record.EventRecorder, obj client.Object) (*A2AAgentCard, error) {
+	ctx, cancel := context.WithTimeout(ctx, a2aDiscoveryDeadlineFactor*a2aDiscoveryTimeoutFrom(ctx))
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		req, err := createA2ARequest(ctx, cardURL, headers, k8sClient, namespace, recorder, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		agentCard, err := executeA2ARequest(ctx, req, address, a2aDiscoveryTimeoutFrom(ctx), recorder, obj)
+		if err == nil || attempt >= a2aDiscoveryRetries || ctx.Err() != nil || !isA2ADiscoveryRetryable(err) {
+			return agentCard, err
+		}
+		if !AllowRetry(ctx, "A2A discovery from "+cardURL) {
+			return nil, fmt.Errorf("retry budget exhausted during A2A discovery: %w", err)
+		}
+
+		backoff := min(a2aDiscoveryBaseBackoff<<attempt, a2aDiscoveryMaxBackoff)
+		if recorder != nil && obj != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "A2ADiscoveryRetry", fmt.Sprintf("Retrying discovery from %s in %s (attempt %d of %d): %v", cardURL, backoff, attempt+2, a2aDiscoveryRetries+1, err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("A2A discovery from %s cancelled: %w", cardURL, ctx.Err())
+		case <-time.After(backoff):
+		}
+	}
+}
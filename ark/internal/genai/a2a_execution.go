@@ -5,6 +5,7 @@ package genai
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/openai/openai-go"
@@ -61,14 +62,24 @@ func (e *A2AExecutionEngine) Execute(ctx context.Context, agentName, namespace s
 		return nil, fmt.Errorf("unable to get A2AServer %v: %w", serverKey, err)
 	}
 
-	// Extract content from the userInput message
-	content := ""
-	if userInput.OfUser != nil && userInput.OfUser.Content.OfString.Value != "" {
-		content = userInput.OfUser.Content.OfString.Value
+	// Execute A2A agent with event recording on the A2AServer, failing over across the server's resolved addresses
+	addresses := defaultA2AAddressSelector.Order(serverKey.String(), a2aServer.Spec.AddressSelection, orderA2AAddresses(a2aAddress, a2aServer.Status.ResolvedAddresses))
+	// Card refreshes use the server's discovery timeout, and each task sent is bounded by its execution timeout
+	usageCtx, usage := withA2ATokenUsage(WithA2AExecutionTimeout(WithA2ADiscoveryTimeout(ctx, a2aServer.Spec.DiscoveryTimeout), a2aServer.Spec.ExecutionTimeout))
+	usageCtx, toolCalls := withA2AToolCalls(usageCtx)
+	usageCtx, taskHistory := withA2ATaskHistory(usageCtx)
+	// Streaming queries get the agent's text as it arrives, when its card advertises streaming
+	var stream *a2aStream
+	if eventStream != nil {
+		usageCtx, stream = withA2AStream(usageCtx, func(text string) error {
+			e.streamChunk(ctx, eventStream, agentName, text, "")
+			return nil
+		})
+	}
+	response, servedBy, err := ExecuteA2AAgentWithFailover(usageCtx, e.client, addresses, a2aServer.Spec.Headers, namespace, a2aMessageParts(userInput), agentName, kubernetesEventRecorder(e.recorder), &a2aServer)
+	if servedBy != "" {
+		a2aAddress = servedBy
 	}
-
-	// Execute A2A agent with event recording
-	response, err := ExecuteA2AAgentWithRecorder(ctx, e.client, a2aAddress, a2aServer.Spec.Headers, namespace, content, agentName, nil, &a2aServer)
 	if err != nil {
 		a2aTracker.Fail(err)
 		e.recorder.EmitEvent(ctx, "Warning", "A2AExecutionFailed", BaseEvent{
@@ -99,45 +110,73 @@ func (e *A2AExecutionEngine) Execute(ctx context.Context, agentName, namespace s
 		},
 	})
 
-	a2aTracker.CompleteWithMetadata(response, map[string]string{
+	// Tool calls the agent reported come first, so its tool usage reads like a native agent's
+	messages := a2aToolCallMessages(agentName, toolCalls.list())
+	messages = append(messages, NewAssistantMessage(response))
+
+	// Opted-in agents keep the A2A conversation turns in session memory, ahead of the tool calls and response
+	if annotations[arkann.A2AMemoryHistory] == TrueString {
+		recordA2AMemoryHistory(ctx, a2aHistoryMessagesForMemory(taskHistory.list()), messages[0])
+	}
+
+	// Token usage reported by the agent flows into team and query token accounting
+	a2aTracker.CompleteWithTokensAndMetadata(response, usage.total(), map[string]string{
 		"responseLength": fmt.Sprintf("%d", len(response)),
 		"hasError":       "false",
-		"messageCount":   "1",
+		"messageCount":   fmt.Sprintf("%d", len(messages)),
 	})
 
-	// Convert response to genai.Message format
-	responseMessage := NewAssistantMessage(response)
-
-	// The A2A execution engine does not yet support streaming responses - if streaming
-	// was requested then the final response must be sent as a single chunk, as per the spec.
+	// A streamed response ends with an empty stop chunk. Responses that were not streamed are sent
+	// as a single chunk, as per the spec.
 	if eventStream != nil {
-		// Use query ID as completion ID (all chunks for a query share the same ID)
-		completionID := getQueryID(ctx)
-		// Use "agent/name" format as per OpenAI-compatible endpoints
-		modelID := fmt.Sprintf("agent/%s", agentName)
-
-		chunk := &openai.ChatCompletionChunk{
-			ID:      completionID,
-			Object:  "chat.completion.chunk",
-			Created: time.Now().Unix(),
-			Model:   modelID,
-			Choices: []openai.ChatCompletionChunkChoice{
-				{
-					Index: 0,
-					Delta: openai.ChatCompletionChunkChoiceDelta{
-						Content: response,
-						Role:    "assistant",
-					},
-					FinishReason: "stop",
+		if stream.started {
+			e.streamChunk(ctx, eventStream, agentName, "", "stop")
+		} else {
+			e.streamChunk(ctx, eventStream, agentName, response, "stop")
+		}
+	}
+
+	return messages, nil
+}
+
+// streamChunk sends agent text to the event stream as an OpenAI-compatible completion chunk.
+// A failed send is logged and does not stop the execution.
+func (e *A2AExecutionEngine) streamChunk(ctx context.Context, eventStream EventStreamInterface, agentName, content, finishReason string) {
+	// Use query ID as completion ID (all chunks for a query share the same ID)
+	completionID := getQueryID(ctx)
+	// Use "agent/name" format as per OpenAI-compatible endpoints
+	modelID := fmt.Sprintf("agent/%s", agentName)
+
+	chunk := &openai.ChatCompletionChunk{
+		ID:      completionID,
+		Object:  "chat.completion.chunk",
+		Created: time.Now().Unix(),
+		Model:   modelID,
+		Choices: []openai.ChatCompletionChunkChoice{
+			{
+				Index: 0,
+				Delta: openai.ChatCompletionChunkChoiceDelta{
+					Content: content,
+					Role:    "assistant",
 				},
+				FinishReason: finishReason,
 			},
-		}
+		},
+	}
 
-		chunkWithMeta := WrapChunkWithMetadata(ctx, chunk, modelID)
-		if err := eventStream.StreamChunk(ctx, chunkWithMeta); err != nil {
-			log.Error(err, "failed to send A2A response chunk to event stream")
-		}
+	chunkWithMeta := WrapChunkWithMetadata(ctx, chunk, modelID)
+	if err := eventStream.StreamChunk(ctx, chunkWithMeta); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to send A2A response chunk to event stream")
 	}
+}
 
-	return []Message{responseMessage}, nil
+// orderA2AAddresses returns the primary address followed by the remaining known addresses, without duplicates
+func orderA2AAddresses(primary string, known []string) []string {
+	addresses := []string{primary}
+	for _, address := range known {
+		if address != "" && !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
 }
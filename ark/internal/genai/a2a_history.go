@@ -0,0 +1,141 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openai/openai-go"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+const (
+	a2aTaskHistoryKey   contextKey = "a2aTaskHistory"
+	a2aMemoryHistoryKey contextKey = "a2aMemoryHistory"
+)
+
+// a2aTaskHistory accumulates the task history returned by A2A agents during one execution
+type a2aTaskHistory struct {
+	mu       sync.Mutex
+	messages []protocol.Message
+}
+
+// withA2ATaskHistory attaches a task history accumulator to the context for A2A calls made with it
+func withA2ATaskHistory(ctx context.Context) (context.Context, *a2aTaskHistory) {
+	history := &a2aTaskHistory{}
+	return context.WithValue(ctx, a2aTaskHistoryKey, history), history
+}
+
+func (h *a2aTaskHistory) list() []protocol.Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]protocol.Message(nil), h.messages...)
+}
+
+// recordA2ATaskHistory adds the history of a task to the context's accumulator, if any
+func recordA2ATaskHistory(ctx context.Context, task *protocol.Task) {
+	acc, _ := ctx.Value(a2aTaskHistoryKey).(*a2aTaskHistory)
+	if acc == nil || task == nil {
+		return
+	}
+
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	acc.messages = append(acc.messages, task.History...)
+}
+
+// ConvertA2ATaskHistoryToMessages converts the user and agent turns of an A2A task history into
+// conversation messages. Reasoning and tool call messages and messages without text are skipped.
+func ConvertA2ATaskHistoryToMessages(history []protocol.Message) []Message {
+	messages := make([]Message, 0, len(history))
+	for _, msg := range history {
+		if isA2AReasoningMessage(msg) || isA2AToolCallMessage(msg) {
+			continue
+		}
+		text := extractTextFromParts(msg.Parts)
+		if text == "" {
+			continue
+		}
+
+		role, err := NormalizeRole(string(msg.Role))
+		if err != nil {
+			continue
+		}
+		switch role {
+		case RoleUser:
+			messages = append(messages, NewUserMessage(text))
+		case RoleAssistant:
+			messages = append(messages, NewAssistantMessage(text))
+		}
+	}
+	return messages
+}
+
+// a2aHistoryMessagesForMemory returns the turns of the A2A conversation that Ark does not already
+// hold. The user input it sent opens the history, and the agent turns after the last user turn
+// make up the response, which is stored separately.
+func a2aHistoryMessagesForMemory(history []protocol.Message) []Message {
+	messages := ConvertA2ATaskHistoryToMessages(history)
+	if len(messages) > 0 && messages[0].OfUser != nil {
+		messages = messages[1:]
+	}
+	for len(messages) > 0 && messages[len(messages)-1].OfAssistant != nil {
+		messages = messages[:len(messages)-1]
+	}
+	return messages
+}
+
+// A2AMemoryHistory collects the A2A conversation turns of agents annotated with
+// ark.mckinsey.com/a2a-memory-history. The turns are kept in session memory only and are
+// not part of the conversation the agent returns.
+type A2AMemoryHistory struct {
+	mu      sync.Mutex
+	entries []a2aMemoryHistoryEntry
+}
+
+// a2aMemoryHistoryEntry holds the turns of one A2A call and the first message the agent returned for it
+type a2aMemoryHistoryEntry struct {
+	returned *openai.ChatCompletionAssistantMessageParam
+	messages []Message
+}
+
+// WithA2AMemoryHistory attaches an A2A memory history collector to the context for agents executed with it
+func WithA2AMemoryHistory(ctx context.Context) (context.Context, *A2AMemoryHistory) {
+	history := &A2AMemoryHistory{}
+	return context.WithValue(ctx, a2aMemoryHistoryKey, history), history
+}
+
+// Merge places each agent's conversation turns ahead of the messages that agent returned, so a team's
+// conversation keeps its order. Turns of agents whose messages are not in the conversation, such as
+// agents called as tools, are left out.
+func (h *A2AMemoryHistory) Merge(messages []Message) []Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.entries) == 0 {
+		return messages
+	}
+
+	merged := make([]Message, 0, len(messages))
+	for _, msg := range messages {
+		for _, entry := range h.entries {
+			if msg.OfAssistant != nil && msg.OfAssistant == entry.returned {
+				merged = append(merged, entry.messages...)
+			}
+		}
+		merged = append(merged, msg)
+	}
+	return merged
+}
+
+// recordA2AMemoryHistory adds the conversation turns of an A2A call to the context's collector, if any.
+// returned is the first message the agent returned for the call.
+func recordA2AMemoryHistory(ctx context.Context, messages []Message, returned Message) {
+	acc, _ := ctx.Value(a2aMemoryHistoryKey).(*A2AMemoryHistory)
+	if acc == nil || len(messages) == 0 || returned.OfAssistant == nil {
+		return
+	}
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	acc.entries = append(acc.entries, a2aMemoryHistoryEntry{returned: returned.OfAssistant, messages: messages})
+}
@@ -0,0 +1,131 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+
+	arkann "mckinsey.com/ark/internal/annotations"
+)
+
+func TestConvertA2ATaskHistoryToMessages(t *testing.T) {
+	history := []protocol.Message{
+		{Role: protocol.MessageRoleUser, Parts: []protocol.Part{protocol.NewTextPart("Plan a trip")}},
+		{
+			Role:     protocol.MessageRoleAgent,
+			Metadata: map[string]interface{}{A2AMessageKindMetadataKey: A2AMessageKindReasoning},
+			Parts:    []protocol.Part{protocol.NewTextPart("Thinking...")},
+		},
+		{Role: protocol.MessageRoleAgent, Parts: []protocol.Part{protocol.NewTextPart("Where to?")}},
+		{Role: protocol.MessageRoleUser, Parts: []protocol.Part{protocol.NewTextPart("Paris")}},
+		{Role: protocol.MessageRoleAgent},
+	}
+
+	assert.Equal(t, []Message{
+		NewUserMessage("Plan a trip"),
+		NewAssistantMessage("Where to?"),
+		NewUserMessage("Paris"),
+	}, ConvertA2ATaskHistoryToMessages(history))
+}
+
+func TestA2AAgentKeepsTaskHistoryForMemory(t *testing.T) {
+	srv := newA2AConversationServer(t)
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		merge    bool
+		expected []Message
+	}{
+		{
+			name:     "history is discarded by default",
+			expected: []Message{NewAssistantMessage("Where to?\nBooked Paris")},
+		},
+		{
+			name:  "history is kept for memory when annotated",
+			merge: true,
+			expected: []Message{
+				NewAssistantMessage("Where to?"),
+				NewUserMessage("Paris"),
+				NewAssistantMessage("Where to?\nBooked Paris"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := newTestA2AAgent(t, &mockRecorder{}, srv.URL)
+			if tt.merge {
+				remote.Annotations[arkann.A2AMemoryHistory] = TrueString
+			}
+			ctx, history := WithA2AMemoryHistory(context.Background())
+			messages, err := remote.Execute(ctx, NewUserMessage("Plan a trip"), nil, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []Message{NewAssistantMessage("Where to?\nBooked Paris")}, messages)
+			assert.Equal(t, tt.expected, history.Merge(messages))
+		})
+	}
+}
+
+func TestA2ATeamMemberKeepsTaskHistoryForMemory(t *testing.T) {
+	srv := newA2AConversationServer(t)
+	defer srv.Close()
+
+	remote := newTestA2AAgent(t, &mockRecorder{}, srv.URL)
+	remote.Annotations[arkann.A2AMemoryHistory] = TrueString
+	first := &fakeTeamMember{name: "planner", reply: "Let me hand this over"}
+	team := &Team{Name: "team", Namespace: "default", Strategy: "sequential", Members: []TeamMember{first, remote}, Recorder: &mockRecorder{}}
+
+	ctx, history := WithA2AMemoryHistory(context.Background())
+	messages, err := team.Execute(ctx, NewUserMessage("Plan a trip"), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	// The member's turns sit between the earlier member's answer and its own response
+	merged := history.Merge(messages)
+	require.Len(t, merged, 4)
+	assert.Equal(t, messages[0], merged[0])
+	assert.Equal(t, NewAssistantMessage("Where to?"), merged[1])
+	assert.Equal(t, NewUserMessage("Paris"), merged[2])
+	assert.Equal(t, messages[1], merged[3])
+}
+
+// newA2AConversationServer returns an A2A server whose task history holds a clarifying question
+func newA2AConversationServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"task","id":"t1","contextId":"c1",
+			"status":{"state":"completed"},
+			"history":[
+				{"kind":"message","messageId":"m1","role":"user","parts":[{"kind":"text","text":"Plan a trip"}]},
+				{"kind":"message","messageId":"m2","role":"agent","parts":[{"kind":"text","text":"Where to?"}]},
+				{"kind":"message","messageId":"m3","role":"user","parts":[{"kind":"text","text":"Paris"}]},
+				{"kind":"message","messageId":"m4","role":"agent","parts":[{"kind":"text","text":"Booked Paris"}]}]}}`, req.ID)
+	}))
+}
+
+func TestA2AMemoryHistoryMergeDropsUnreturnedTurns(t *testing.T) {
+	ctx, history := WithA2AMemoryHistory(context.Background())
+	response := NewAssistantMessage("answer")
+	recordA2AMemoryHistory(ctx, []Message{NewUserMessage("kept")}, response)
+	// An A2A agent called as a tool returns its answer as a tool result, outside the conversation
+	recordA2AMemoryHistory(ctx, []Message{NewUserMessage("dropped")}, NewAssistantMessage("tool answer"))
+
+	assert.Equal(t, []Message{NewUserMessage("kept"), response}, history.Merge([]Message{response}))
+}
@@ -0,0 +1,100 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+// CallA2AMethod invokes an arbitrary JSON-RPC method on an A2A server and decodes the result into result.
+// JSON-RPC level failures are returned as *A2AJSONRPCError so callers can inspect the error code.
+func CallA2AMethod(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace, method string, params, result any) error {
+	rpcURL := strings.TrimSuffix(address, "/")
+
+	body, err := json.Marshal(A2AJSONRPCRequest{
+		JSONRPC: "2.0",
+		ID:      protocol.GenerateRPCID(),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal A2A request for method %s: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setUserAgent(req.Header)
+
+	if len(headers) > 0 {
+		resolvedHeaders, err := resolveA2AHeaders(ctx, k8sClient, headers, namespace)
+		if err != nil {
+			return err
+		}
+		for name, value := range resolvedHeaders {
+			req.Header.Set(name, value)
+		}
+	}
+
+	headerMap := make(map[string]string)
+	telemetry.InjectOTELHeaders(ctx, headerMap)
+	for name, value := range headerMap {
+		req.Header.Set(name, value)
+	}
+
+	if err := prepareA2ACompression(req); err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to A2A server: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logf.FromContext(ctx).Error(closeErr, "failed to close response body")
+		}
+	}()
+
+	if err := decompressA2AResponse(resp); err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return &A2AStatusError{StatusCode: resp.StatusCode, Method: method}
+	}
+
+	var rpcResp A2AJSONRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("failed to parse A2A response for method %s: %w", method, err)
+	}
+
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+
+	if result == nil || len(rpcResp.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(rpcResp.Result, result); err != nil {
+		return fmt.Errorf("failed to decode result of method %s: %w", method, err)
+	}
+	return nil
+}
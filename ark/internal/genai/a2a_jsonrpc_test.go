@@ -0,0 +1,80 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+func TestCallA2AMethod(t *testing.T) {
+	tests := []struct {
+		name         string
+		response     string
+		status       int
+		expected     map[string]string
+		expectedCode int
+		expectedType string
+		expectError  bool
+	}{
+		{
+			name:     "successful call decodes result",
+			response: `{"jsonrpc":"2.0","id":"1","result":{"artifact":"report"}}`,
+			status:   http.StatusOK,
+			expected: map[string]string{"artifact": "report"},
+		},
+		{
+			name:         "json-rpc error is returned typed",
+			response:     `{"jsonrpc":"2.0","id":"1","error":{"code":-32601,"message":"Method not found"}}`,
+			status:       http.StatusOK,
+			expectedCode: -32601,
+			expectedType: telemetry.ErrorTypeInvalidRequest,
+			expectError:  true,
+		},
+		{
+			name:         "non-200 status",
+			response:     `oops`,
+			status:       http.StatusBadGateway,
+			expectedType: telemetry.ErrorTypeServer,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received A2AJSONRPCRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&received)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			var result map[string]string
+			err := CallA2AMethod(context.Background(), nil, server.URL, nil, "default", "task/artifacts", map[string]string{"id": "task-1"}, &result)
+
+			assert.Equal(t, "2.0", received.JSONRPC)
+			assert.Equal(t, "task/artifacts", received.Method)
+			assert.NotEmpty(t, received.ID)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedType, telemetry.ClassifyError(err))
+				if tt.expectedCode != 0 {
+					var rpcErr *A2AJSONRPCError
+					require.ErrorAs(t, err, &rpcErr)
+					assert.Equal(t, tt.expectedCode, rpcErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+// a2aMessageParts converts a user message into A2A message parts. Text content parts become text
+// parts, while images, audio and files become file parts, so structured query input reaches the agent.
+func a2aMessageParts(userInput Message) []protocol.Part {
+	if userInput.OfUser == nil {
+		return []protocol.Part{protocol.NewTextPart("")}
+	}
+	content := userInput.OfUser.Content
+	if len(content.OfArrayOfContentParts) == 0 {
+		return []protocol.Part{protocol.NewTextPart(content.OfString.Value)}
+	}
+
+	parts := make([]protocol.Part, 0, len(content.OfArrayOfContentParts))
+	for _, part := range content.OfArrayOfContentParts {
+		switch {
+		case part.OfText != nil:
+			parts = append(parts, protocol.NewTextPart(part.OfText.Text))
+		case part.OfImageURL != nil:
+			parts = append(parts, a2aFilePartFromURL("", part.OfImageURL.ImageURL.URL))
+		case part.OfInputAudio != nil:
+			audio := part.OfInputAudio.InputAudio
+			parts = append(parts, protocol.NewFilePartWithBytes("", "audio/"+audio.Format, audio.Data))
+		case part.OfFile != nil && part.OfFile.File.FileData.Value != "":
+			// Files referenced by a provider file ID cannot be resolved by the agent and are left out
+			file := part.OfFile.File
+			parts = append(parts, a2aFilePartFromURL(file.Filename.Value, file.FileData.Value))
+		}
+	}
+	return parts
+}
+
+// a2aFilePartFromURL returns a file part carrying the bytes of a base64 data URL, or a reference to any other URL
+func a2aFilePartFromURL(name, fileURL string) protocol.Part {
+	if data, ok := strings.CutPrefix(fileURL, "data:"); ok {
+		mediaType, encoded, found := strings.Cut(data, ",")
+		if mimeType, isBase64 := strings.CutSuffix(mediaType, ";base64"); found && isBase64 {
+			return protocol.NewFilePartWithBytes(name, mimeType, encoded)
+		}
+	}
+
+	mimeType := ""
+	if parsed, err := url.Parse(fileURL); err == nil {
+		if name == "" {
+			name = path.Base(parsed.Path)
+		}
+		mimeType, _, _ = strings.Cut(mime.TypeByExtension(path.Ext(parsed.Path)), ";")
+	}
+	return protocol.NewFilePartWithURI(name, mimeType, fileURL)
+}
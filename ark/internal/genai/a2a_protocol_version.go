@@ -0,0 +1,47 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+// supportedA2AProtocolVersions are the major.minor protocol versions ARK discovers and calls
+var supportedA2AProtocolVersions = []string{"0.2", "0.3"}
+
+// A2AUnsupportedProtocolError is returned when an agent card declares a protocol version ARK does not speak
+type A2AUnsupportedProtocolError struct {
+	Agent   string
+	Version string
+}
+
+func (e *A2AUnsupportedProtocolError) Error() string {
+	return fmt.Sprintf("agent %s uses A2A protocol version %s, supported versions are %s",
+		e.Agent, e.Version, strings.Join(supportedA2AProtocolVersions, ".x, ")+".x")
+}
+
+func (e *A2AUnsupportedProtocolError) ErrorType() string {
+	return telemetry.ErrorTypeInvalidRequest
+}
+
+// checkA2AProtocolVersion rejects cards for protocol versions outside the supported range.
+// Cards without a protocol version are accepted.
+func checkA2AProtocolVersion(card *A2AAgentCard) error {
+	if card == nil || card.ProtocolVersion == nil {
+		return nil
+	}
+	version := strings.TrimSpace(*card.ProtocolVersion)
+	if version == "" {
+		return nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) >= 2 && slices.Contains(supportedA2AProtocolVersions, parts[0]+"."+parts[1]) {
+		return nil
+	}
+	return &A2AUnsupportedProtocolError{Agent: card.Name, Version: version}
+}
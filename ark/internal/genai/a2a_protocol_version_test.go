@@ -0,0 +1,55 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+func TestCheckA2AProtocolVersion(t *testing.T) {
+	version := func(v string) *A2AAgentCard { return &A2AAgentCard{Name: "weather", ProtocolVersion: &v} }
+
+	assert.NoError(t, checkA2AProtocolVersion(nil))
+	assert.NoError(t, checkA2AProtocolVersion(&A2AAgentCard{Name: "weather"}))
+	assert.NoError(t, checkA2AProtocolVersion(version("")))
+	assert.NoError(t, checkA2AProtocolVersion(version("0.2.5")))
+	assert.NoError(t, checkA2AProtocolVersion(version("0.3.0")))
+	assert.NoError(t, checkA2AProtocolVersion(version("v0.3")))
+
+	for _, unsupported := range []string{"1.0.0", "0.1.0", "0.30.0", "latest"} {
+		err := checkA2AProtocolVersion(version(unsupported))
+		var protocolErr *A2AUnsupportedProtocolError
+		require.ErrorAs(t, err, &protocolErr, unsupported)
+		assert.Equal(t, unsupported, protocolErr.Version)
+	}
+}
+
+func TestDiscoverA2AAgentsRejectsUnsupportedProtocol(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"weather","url":"http://weather","version":"1.0.0","protocolVersion":"1.0.0",
+			"capabilities":{},"defaultInputModes":["text"],"defaultOutputModes":["text"],"skills":[]}`))
+	}))
+	defer srv.Close()
+
+	recorder := record.NewFakeRecorder(10)
+	_, err := DiscoverA2AAgentsWithRecorder(context.Background(), nil, srv.URL, nil, "default", recorder, &arkv1prealpha1.A2AServer{})
+
+	var protocolErr *A2AUnsupportedProtocolError
+	require.ErrorAs(t, err, &protocolErr)
+	assert.Contains(t, err.Error(), "supported versions are 0.2.x, 0.3.x")
+	assert.Equal(t, []string{AgentCardPathVersion3}, requests)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "A2AUnsupportedProtocol")
+}
@@ -0,0 +1,88 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultA2AMaxResponseBytes bounds the text Ark assembles from a single A2A response
+const DefaultA2AMaxResponseBytes = 1 << 20
+
+// a2aMaxResponseBytes is the current limit. Zero or less disables it.
+var a2aMaxResponseBytes = DefaultA2AMaxResponseBytes
+
+// errA2AResponseTruncated stops a stream once the response limit is reached
+var errA2AResponseTruncated = errors.New("A2A response truncated")
+
+// SetA2AMaxResponseBytes sets the maximum size of the text assembled from an A2A response.
+// Longer responses are cut off with a marker. Zero or less disables the limit.
+func SetA2AMaxResponseBytes(limit int) {
+	a2aMaxResponseBytes = limit
+}
+
+// a2aResponseBuilder assembles response text up to the configured limit
+type a2aResponseBuilder struct {
+	text      strings.Builder
+	limit     int
+	truncated bool
+}
+
+func newA2AResponseBuilder() *a2aResponseBuilder {
+	return &a2aResponseBuilder{limit: a2aMaxResponseBytes}
+}
+
+// WriteString appends s, or as much of it as fits, and returns the part that was kept
+func (b *a2aResponseBuilder) WriteString(s string) string {
+	if b.truncated {
+		return ""
+	}
+	if b.limit > 0 && b.text.Len()+len(s) > b.limit {
+		n := b.limit - b.text.Len()
+		// Cut on a rune boundary so the kept text stays valid UTF-8
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		s = s[:n]
+		b.truncated = true
+	}
+	b.text.WriteString(s)
+	return s
+}
+
+func (b *a2aResponseBuilder) Len() int {
+	return b.text.Len()
+}
+
+func (b *a2aResponseBuilder) Truncated() bool {
+	return b.truncated
+}
+
+// String returns the assembled text, followed by a marker if it was truncated
+func (b *a2aResponseBuilder) String() string {
+	return b.text.String() + b.Marker()
+}
+
+// Marker returns the text appended to a truncated response, or an empty string
+func (b *a2aResponseBuilder) Marker() string {
+	if !b.truncated {
+		return ""
+	}
+	return fmt.Sprintf("\n\n[Response truncated at %d bytes]", b.limit)
+}
+
+func recordA2AResponseTruncated(ctx context.Context, agentName string, recorder record.EventRecorder, obj client.Object) {
+	logf.FromContext(ctx).Info("A2A response truncated", "agent", agentName, "limit", a2aMaxResponseBytes)
+	if recorder != nil && obj != nil {
+		recorder.Event(obj, corev1.EventTypeWarning, "A2AResponseTruncated", fmt.Sprintf("Response from agent %s exceeded %d bytes and was truncated", agentName, a2aMaxResponseBytes))
+	}
+}
@@ -0,0 +1,71 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+func withA2AMaxResponseBytes(t *testing.T, limit int) {
+	t.Helper()
+	previous := a2aMaxResponseBytes
+	SetA2AMaxResponseBytes(limit)
+	t.Cleanup(func() { SetA2AMaxResponseBytes(previous) })
+}
+
+func TestA2AResponseBuilderCutsOnRuneBoundary(t *testing.T) {
+	withA2AMaxResponseBytes(t, 4)
+
+	b := newA2AResponseBuilder()
+	assert.Equal(t, "ab", b.WriteString("ab"))
+	assert.Equal(t, "c", b.WriteString("cé€"), "a multi-byte rune that does not fit is dropped whole")
+	assert.True(t, b.Truncated())
+	assert.Empty(t, b.WriteString("more"))
+	assert.Equal(t, "abc\n\n[Response truncated at 4 bytes]", b.String())
+}
+
+func TestExtractTextFromMessageResultTruncates(t *testing.T) {
+	withA2AMaxResponseBytes(t, 10)
+
+	task := &protocol.Task{
+		Status: protocol.TaskStatus{State: TaskStateCompleted},
+		History: []protocol.Message{
+			{Role: protocol.MessageRoleAgent, Parts: []protocol.Part{protocol.NewTextPart("first")}},
+			{Role: protocol.MessageRoleAgent, Parts: []protocol.Part{protocol.NewTextPart("second message")}},
+		},
+	}
+	text, truncated, err := extractTextFromMessageResult(&protocol.MessageResult{Result: task})
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.True(t, strings.HasPrefix(text, "first\nseco\n\n[Response truncated"))
+
+	message := &protocol.Message{Parts: []protocol.Part{protocol.NewTextPart("short")}}
+	text, truncated, err = extractTextFromMessageResult(&protocol.MessageResult{Result: message})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, "short", text)
+}
+
+func TestExecuteA2AAgentStreamTruncates(t *testing.T) {
+	withA2AMaxResponseBytes(t, 8)
+
+	closed := make(chan struct{})
+	srv := newA2AStreamServer(t, []string{artifactEvent("Hello "), artifactEvent("world")}, true, closed)
+	defer srv.Close()
+
+	var chunks []string
+	response, err := ExecuteA2AAgentStream(context.Background(), nil, srv.URL, nil, "default", "hi", "agent", streamingCard(true), func(text string) error {
+		chunks = append(chunks, text)
+		return nil
+	}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello wo\n\n[Response truncated at 8 bytes]", response)
+	assert.Equal(t, []string{"Hello ", "wo", "\n\n[Response truncated at 8 bytes]"}, chunks)
+	<-closed
+}
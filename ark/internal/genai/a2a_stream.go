@@ -0,0 +1,225 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	a2aclient "trpc.group/trpc-go/trpc-a2a-go/client"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+const a2aStreamKey contextKey = "a2aStream"
+
+// A2AStreamHandler receives each piece of agent text as it arrives. Returning an error stops the stream.
+type A2AStreamHandler func(text string) error
+
+// a2aStream forwards the text of streamed A2A responses and remembers whether any was sent
+type a2aStream struct {
+	onText  A2AStreamHandler
+	started bool
+}
+
+// withA2AStream makes A2A calls made with the context stream their responses to onText
+// when the agent card advertises streaming
+func withA2AStream(ctx context.Context, onText A2AStreamHandler) (context.Context, *a2aStream) {
+	stream := &a2aStream{onText: onText}
+	return context.WithValue(ctx, a2aStreamKey, stream), stream
+}
+
+func (s *a2aStream) send(text string) error {
+	if text == "" {
+		return nil
+	}
+	s.started = true
+	return s.onText(text)
+}
+
+// a2aStreamStarted reports whether a streamed response has already sent text for the context.
+// Such a call is neither retried nor failed over, so the text is never sent twice.
+func a2aStreamStarted(ctx context.Context) bool {
+	stream, _ := ctx.Value(a2aStreamKey).(*a2aStream)
+	return stream != nil && stream.started
+}
+
+// ExecuteA2AAgentStream executes a task on an A2A agent and passes text to onText as it arrives.
+// When no card is given, the card last discovered at the address is used. The call gets the same
+// capability check and card refresh as ExecuteA2AAgentWithParts. Agents whose card does not advertise
+// streaming are called in blocking mode and onText receives the whole response once. On error, the
+// text received so far is returned along with the error.
+func ExecuteA2AAgentStream(ctx context.Context, k8sClient client.Client, address string, headers []arkv1prealpha1.Header, namespace, input, agentName string, agentCard *A2AAgentCard, onText A2AStreamHandler, recorder record.EventRecorder, obj client.Object) (string, error) {
+	if agentCard == nil {
+		agentCard = discoveredA2AAgentCard(address)
+	}
+
+	streamCtx, stream := withA2AStream(ctx, onText)
+	response, err := ExecuteA2AAgentWithParts(streamCtx, k8sClient, address, headers, namespace, []protocol.Part{protocol.NewTextPart(input)}, agentName, agentCard, recorder, obj)
+	if err != nil || stream.started {
+		return response, err
+	}
+	return response, stream.send(response)
+}
+
+// supportsA2AStreaming reports whether the agent card advertises streaming responses
+func supportsA2AStreaming(agentCard *A2AAgentCard) bool {
+	return agentCard != nil && agentCard.Capabilities.Streaming != nil && *agentCard.Capabilities.Streaming
+}
+
+// streamA2AAgentMessage sends a message with message/stream and forwards agent text until the task finishes.
+// Token usage, tool calls and task history are captured like a blocking call's.
+func streamA2AAgentMessage(ctx context.Context, a2aClient *a2aclient.A2AClient, parts []protocol.Part, agentName, rpcURL string, stream *a2aStream, recorder record.EventRecorder, obj client.Object) (string, error) {
+	// Cancelling the stream context on return stops the client's reader goroutine and closes the connection
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	params := protocol.SendMessageParams{
+		RPCID:   protocol.GenerateRPCID(),
+		Message: protocol.NewMessage(protocol.MessageRoleUser, parts),
+	}
+
+	events, err := a2aClient.StreamMessage(streamCtx, params)
+	if err != nil {
+		if recorder != nil && obj != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "A2AExecutionFailed", fmt.Sprintf("A2A agent %s execution failed at %s: %v", agentName, rpcURL, err))
+		}
+		return "", fmt.Errorf("A2A server call failed: %w", err)
+	}
+
+	// task collects the latest task and the status messages sent after it, for tool calls and history
+	var task *protocol.Task
+	response := newA2AResponseBuilder()
+	emit := func(text string) error {
+		if text == "" {
+			return nil
+		}
+		if err := stream.send(response.WriteString(text)); err != nil {
+			return err
+		}
+		if response.Truncated() {
+			if err := stream.send(response.Marker()); err != nil {
+				return err
+			}
+			return errA2AResponseTruncated
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return response.String(), ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				err := fmt.Errorf("A2A stream from agent %s ended before the task finished", agentName)
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				if recorder != nil && obj != nil {
+					recorder.Event(obj, corev1.EventTypeWarning, "A2AExecutionFailed", fmt.Sprintf("A2A agent %s execution failed at %s: %v", agentName, rpcURL, err))
+				}
+				return response.String(), err
+			}
+
+			switch e := event.Result.(type) {
+			case *protocol.Message:
+				recordA2ATokenUsage(ctx, e.Metadata)
+			case *protocol.Task:
+				recordA2ATokenUsage(ctx, e.Metadata)
+				task = a2aStreamTaskSnapshot(e)
+			case *protocol.TaskStatusUpdateEvent:
+				recordA2ATokenUsage(ctx, e.Metadata)
+				if msg := e.Status.Message; msg != nil {
+					if task == nil {
+						task = &protocol.Task{ID: e.TaskID, ContextID: e.ContextID}
+					}
+					task.History = append(task.History, *msg)
+				}
+			}
+
+			done, err := handleA2AStreamEvent(event, emit)
+			if errors.Is(err, errA2AResponseTruncated) {
+				recordA2AResponseTruncated(ctx, agentName, recorder, obj)
+				done, err = true, nil
+			}
+			if done {
+				recordA2AToolCalls(ctx, task)
+				recordA2ATaskHistory(ctx, task)
+			}
+			if err != nil {
+				var rejected *A2ATaskRejectedError
+				if recorder != nil && obj != nil {
+					if errors.As(err, &rejected) {
+						recorder.Event(obj, corev1.EventTypeWarning, "A2ATaskRejected", fmt.Sprintf("Agent %s rejected the task: %s", agentName, rejected.Reason))
+					} else {
+						recorder.Event(obj, corev1.EventTypeWarning, "A2AExecutionFailed", fmt.Sprintf("A2A agent %s execution failed at %s: %v", agentName, rpcURL, err))
+					}
+				}
+				return response.String(), err
+			}
+			if done {
+				if recorder != nil && obj != nil {
+					recorder.Event(obj, corev1.EventTypeNormal, "A2AExecutionSuccess", fmt.Sprintf("Successfully executed agent %s, response length: %d characters", agentName, response.Len()))
+				}
+				return response.String(), nil
+			}
+		}
+	}
+}
+
+// a2aStreamTaskSnapshot copies a streamed task so later status messages can be added to its history
+func a2aStreamTaskSnapshot(task *protocol.Task) *protocol.Task {
+	snapshot := *task
+	snapshot.History = append([]protocol.Message(nil), task.History...)
+	return &snapshot
+}
+
+// handleA2AStreamEvent forwards the agent text in one stream event and reports whether the task has finished
+func handleA2AStreamEvent(event protocol.StreamingMessageEvent, emit func(string) error) (bool, error) {
+	switch e := event.Result.(type) {
+	case *protocol.Message:
+		// A plain message is the agent's whole answer, no task is created
+		return true, emit(extractTextFromParts(e.Parts))
+
+	case *protocol.Task:
+		switch e.Status.State {
+		case TaskStateCompleted, TaskStateFailed, TaskStateRejected:
+			text, err := extractTextFromTask(e)
+			if err != nil {
+				return true, err
+			}
+			return true, emit(text)
+		}
+		return false, nil
+
+	case *protocol.TaskArtifactUpdateEvent:
+		return false, emit(extractTextFromParts(e.Artifact.Parts))
+
+	case *protocol.TaskStatusUpdateEvent:
+		if e.Status.State == TaskStateFailed || e.Status.State == TaskStateRejected {
+			_, err := extractTextFromTask(&protocol.Task{Status: e.Status})
+			return true, err
+		}
+		if msg := e.Status.Message; msg != nil && msg.Role == protocol.MessageRoleAgent && !isA2AReasoningMessage(*msg) && !isA2AToolCallMessage(*msg) {
+			if err := emit(extractTextFromParts(msg.Parts)); err != nil {
+				return true, err
+			}
+		}
+		if e.Status.State == TaskStateCompleted {
+			return true, nil
+		}
+		if e.Final {
+			return true, fmt.Errorf("task ended in state '%s'", e.Status.State)
+		}
+		return false, nil
+
+	default:
+		return false, nil
+	}
+}
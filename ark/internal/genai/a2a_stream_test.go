@@ -0,0 +1,217 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/server"
+)
+
+// newA2AStreamServer serves message/stream by writing each event as an SSE data line
+func newA2AStreamServer(t *testing.T, events []string, blockAfter bool, closed chan<- struct{}) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "message/stream", req.Method)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range events {
+			_, _ = fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%q,\"result\":%s}\n\n", req.ID, event)
+			w.(http.Flusher).Flush()
+		}
+		if blockAfter {
+			<-r.Context().Done()
+			close(closed)
+		}
+	}))
+}
+
+func streamingCard(streaming bool) *A2AAgentCard {
+	return &A2AAgentCard{Capabilities: server.AgentCapabilities{Streaming: &streaming}}
+}
+
+func artifactEvent(text string) string {
+	return fmt.Sprintf(`{"kind":"artifact-update","taskId":"t1","contextId":"c1","artifact":{"artifactId":"a1","parts":[{"kind":"text","text":%q}]}}`, text)
+}
+
+func TestExecuteA2AAgentStream(t *testing.T) {
+	t.Run("forwards artifact text as it arrives", func(t *testing.T) {
+		srv := newA2AStreamServer(t, []string{
+			`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"working"},"final":false}`,
+			artifactEvent("Hello "),
+			artifactEvent("world"),
+			`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"completed"},"final":true}`,
+		}, false, nil)
+		defer srv.Close()
+
+		var chunks []string
+		response, err := ExecuteA2AAgentStream(context.Background(), nil, srv.URL, nil, "default", "hi", "agent", streamingCard(true), func(text string) error {
+			chunks = append(chunks, text)
+			return nil
+		}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Hello ", "world"}, chunks)
+		assert.Equal(t, "Hello world", response)
+	})
+
+	t.Run("returns partial text when the task fails", func(t *testing.T) {
+		srv := newA2AStreamServer(t, []string{
+			artifactEvent("partial"),
+			`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"failed","message":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"boom"}]}},"final":true}`,
+		}, false, nil)
+		defer srv.Close()
+
+		response, err := ExecuteA2AAgentStream(context.Background(), nil, srv.URL, nil, "default", "hi", "agent", streamingCard(true), func(string) error { return nil }, nil, nil)
+		require.EqualError(t, err, "boom")
+		assert.Equal(t, "partial", response)
+	})
+
+	t.Run("closes the stream on cancellation", func(t *testing.T) {
+		closed := make(chan struct{})
+		srv := newA2AStreamServer(t, []string{artifactEvent("partial")}, true, closed)
+		defer srv.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		response, err := ExecuteA2AAgentStream(ctx, nil, srv.URL, nil, "default", "hi", "agent", streamingCard(true), func(string) error {
+			cancel()
+			return nil
+		}, nil, nil)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, "partial", response)
+		<-closed
+	})
+
+	t.Run("falls back to blocking mode without streaming support", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req A2AJSONRPCRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "message/send", req.Method)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"all at once"}]}}`, req.ID)
+		}))
+		defer srv.Close()
+
+		var chunks []string
+		response, err := ExecuteA2AAgentStream(context.Background(), nil, srv.URL, nil, "default", "hi", "agent", streamingCard(false), func(text string) error {
+			chunks = append(chunks, text)
+			return nil
+		}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"all at once"}, chunks)
+		assert.Equal(t, "all at once", response)
+	})
+	t.Run("streams for longer than the client timeout", func(t *testing.T) {
+		factory := defaultA2AClientFactory
+		defaultA2AClientFactory = NewA2AClientFactory(100 * time.Millisecond)
+		defer func() { defaultA2AClientFactory = factory }()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req A2AJSONRPCRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, event := range []string{
+				artifactEvent("slow "),
+				artifactEvent("answer"),
+				`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"completed"},"final":true}`,
+			} {
+				_, _ = fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%q,\"result\":%s}\n\n", req.ID, event)
+				w.(http.Flusher).Flush()
+				time.Sleep(150 * time.Millisecond)
+			}
+		}))
+		defer srv.Close()
+
+		response, err := ExecuteA2AAgentStream(context.Background(), nil, srv.URL, nil, "default", "hi", "agent", streamingCard(true), func(string) error { return nil }, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "slow answer", response)
+	})
+}
+
+// recordingEventStream keeps the chunks sent to a query's event stream
+type recordingEventStream struct {
+	chunks []*openai.ChatCompletionChunk
+}
+
+func (s *recordingEventStream) StreamChunk(_ context.Context, chunk interface{}) error {
+	switch c := chunk.(type) {
+	case ChunkWithMetadata:
+		s.chunks = append(s.chunks, c.ChatCompletionChunk)
+	case *openai.ChatCompletionChunk:
+		s.chunks = append(s.chunks, c)
+	}
+	return nil
+}
+
+func (s *recordingEventStream) NotifyCompletion(context.Context) error { return nil }
+
+func (s *recordingEventStream) Close() error { return nil }
+
+func TestA2AAgentStreamsToEventStream(t *testing.T) {
+	srv := newA2AStreamServer(t, []string{
+		`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"working","message":{"kind":"message","messageId":"m1","role":"agent",` +
+			`"metadata":{"ark.mckinsey.com/message-kind":"tool-call","ark.mckinsey.com/tool-call":{"id":"call-1","name":"get_weather","result":"sunny"}},` +
+			`"parts":[{"kind":"text","text":"calling get_weather"}]}}}`,
+		artifactEvent("It is "),
+		artifactEvent("sunny"),
+		`{"kind":"status-update","taskId":"t1","contextId":"c1","status":{"state":"completed"},"final":true,"metadata":{"usage":{"input_tokens":3,"output_tokens":2}}}`,
+	}, false, nil)
+	defer srv.Close()
+	rememberA2AAgentCard(srv.URL, streamingCard(true))
+
+	recorder := NewTokenUsageCollector(&mockRecorder{})
+	agent := newTestA2AAgent(t, recorder, srv.URL)
+	eventStream := &recordingEventStream{}
+
+	messages, err := agent.Execute(context.Background(), NewUserMessage("weather?"), nil, nil, eventStream)
+	require.NoError(t, err)
+
+	var contents, finishReasons []string
+	for _, chunk := range eventStream.chunks {
+		contents = append(contents, chunk.Choices[0].Delta.Content)
+		finishReasons = append(finishReasons, chunk.Choices[0].FinishReason)
+	}
+	assert.Equal(t, []string{"It is ", "sunny", ""}, contents)
+	assert.Equal(t, []string{"", "", "stop"}, finishReasons)
+
+	// The streamed task is captured like a blocking one
+	require.Len(t, messages, 3)
+	require.NotNil(t, messages[0].OfAssistant)
+	require.Len(t, messages[0].OfAssistant.ToolCalls, 1)
+	assert.Equal(t, "get_weather", messages[0].OfAssistant.ToolCalls[0].Function.Name)
+	assert.Equal(t, ToolMessage("sunny", "call-1"), messages[1])
+	assert.Equal(t, NewAssistantMessage("It is sunny"), messages[2])
+	assert.Equal(t, TokenUsage{PromptTokens: 3, CompletionTokens: 2, TotalTokens: 5}, recorder.GetTokenSummary())
+}
+
+func TestA2AAgentStreamFallsBackToBlocking(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "message/send", req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"all at once"}]}}`, req.ID)
+	}))
+	defer srv.Close()
+	rememberA2AAgentCard(srv.URL, streamingCard(false))
+
+	agent := newTestA2AAgent(t, &mockRecorder{}, srv.URL)
+	eventStream := &recordingEventStream{}
+
+	_, err := agent.Execute(context.Background(), NewUserMessage("hi"), nil, nil, eventStream)
+	require.NoError(t, err)
+	require.Len(t, eventStream.chunks, 1)
+	assert.Equal(t, "all at once", eventStream.chunks[0].Choices[0].Delta.Content)
+	assert.Equal(t, "stop", eventStream.chunks[0].Choices[0].FinishReason)
+}
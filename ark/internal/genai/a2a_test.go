@@ -1,10 +1,32 @@
 package genai
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/openai/openai-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
 )
 
 func TestExtractTextFromTask(t *testing.T) {
@@ -125,6 +147,23 @@ func TestExtractTextFromTask(t *testing.T) {
 			expectError: true,
 			errorMsg:    "task failed",
 		},
+		{
+			name: "rejected task with reason",
+			task: &protocol.Task{
+				ID: "task-10",
+				Status: protocol.TaskStatus{
+					State: TaskStateRejected,
+					Message: &protocol.Message{
+						Parts: []protocol.Part{
+							protocol.TextPart{Text: "I only handle weather questions"},
+						},
+					},
+				},
+			},
+			expected:    "",
+			expectError: true,
+			errorMsg:    "task rejected by agent: I only handle weather questions",
+		},
 		{
 			name: "task with no state",
 			task: &protocol.Task{
@@ -147,7 +186,7 @@ func TestExtractTextFromTask(t *testing.T) {
 			},
 			expected:    "",
 			expectError: true,
-			errorMsg:    "task in state 'working' (expected completed or failed)",
+			errorMsg:    "task in state 'working' (expected completed, failed or rejected)",
 		},
 		{
 			name: "completed task with empty history",
@@ -181,6 +220,86 @@ func TestExtractTextFromTask(t *testing.T) {
 			expected:    "Part 1 Part 2",
 			expectError: false,
 		},
+		{
+			name: "completed task skips reasoning messages",
+			task: &protocol.Task{
+				ID: "task-11",
+				Status: protocol.TaskStatus{
+					State: TaskStateCompleted,
+				},
+				History: []protocol.Message{
+					{
+						Role:     protocol.MessageRoleAgent,
+						Metadata: map[string]interface{}{A2AMessageKindMetadataKey: A2AMessageKindReasoning},
+						Parts: []protocol.Part{
+							protocol.TextPart{Text: "Executing function `get_coordinates`..."},
+						},
+					},
+					{
+						Role: protocol.MessageRoleAgent,
+						Parts: []protocol.Part{
+							protocol.TextPart{Text: "It is sunny in Chicago"},
+						},
+					},
+				},
+			},
+			expected:    "It is sunny in Chicago",
+			expectError: false,
+		},
+		{
+			name: "completed task falls back to history when artifacts have no content",
+			task: &protocol.Task{
+				ID: "task-13",
+				Status: protocol.TaskStatus{
+					State: TaskStateCompleted,
+				},
+				History: []protocol.Message{
+					{
+						Role:  protocol.MessageRoleAgent,
+						Parts: []protocol.Part{protocol.TextPart{Text: "The forecast is sunny"}},
+					},
+				},
+				Artifacts: []protocol.Artifact{
+					{ArtifactID: "empty", Parts: []protocol.Part{protocol.TextPart{Text: ""}}},
+				},
+			},
+			expected:    "The forecast is sunny",
+			expectError: false,
+		},
+		{
+			name: "completed task prefers artifacts over history",
+			task: &protocol.Task{
+				ID: "task-12",
+				Status: protocol.TaskStatus{
+					State: TaskStateCompleted,
+				},
+				History: []protocol.Message{
+					{
+						Role:  protocol.MessageRoleAgent,
+						Parts: []protocol.Part{protocol.TextPart{Text: "Looking up the forecast..."}},
+					},
+				},
+				Artifacts: []protocol.Artifact{
+					{
+						ArtifactID: "forecast",
+						Parts: []protocol.Part{
+							&protocol.TextPart{Text: "Sunny, "},
+							&protocol.TextPart{Text: "25°C"},
+							&protocol.DataPart{Data: map[string]any{"temperature": 25}},
+						},
+					},
+					{
+						ArtifactID: "charts",
+						Parts: []protocol.Part{
+							protocol.NewFilePartWithURI("chart.png", "image/png", "https://example.com/chart.png"),
+							protocol.NewFilePartWithBytes("data.csv", "", "aGVsbG8="),
+						},
+					},
+				},
+			},
+			expected:    "Sunny, 25°C\n{\"temperature\":25}\n[file: chart.png (image/png) https://example.com/chart.png]\n[file: data.csv 5 bytes]",
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,6 +310,8 @@ func TestExtractTextFromTask(t *testing.T) {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errorMsg)
 				assert.Equal(t, tt.expected, result)
+				var rejected *A2ATaskRejectedError
+				assert.Equal(t, tt.task.Status.State == TaskStateRejected, errors.As(err, &rejected))
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expected, result)
@@ -249,3 +370,283 @@ func TestExtractTextFromParts(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverA2AAgentsWithFailover(t *testing.T) {
+	shortenA2ADiscoveryBackoff(t)
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != AgentCardPathVersion3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"weather-agent","url":"http://example"}`))
+	}))
+	defer healthy.Close()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	agentCard, servedBy, err := DiscoverA2AAgentsWithFailover(context.Background(), nil, []string{unreachableURL, healthy.URL}, nil, "default", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "weather-agent", agentCard.Name)
+	assert.Equal(t, healthy.URL, servedBy)
+
+	_, _, err = DiscoverA2AAgentsWithFailover(context.Background(), nil, []string{unreachableURL}, nil, "default", nil, nil)
+	assert.Error(t, err)
+
+	_, _, err = DiscoverA2AAgentsWithFailover(context.Background(), nil, nil, nil, "default", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestDiscoverA2AAgentsRecordsAttempts(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previous)
+
+	legacy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != AgentCardPathVersion2 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"legacy-agent","url":"http://example"}`))
+	}))
+	defer legacy.Close()
+
+	_, err := DiscoverA2AAgents(context.Background(), nil, legacy.URL, nil, "default")
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "a2a.discovery", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, attribute.String("a2a.protocol.version", "protocol version 0.2.x"))
+
+	var outcomes []string
+	for _, event := range spans[0].Events {
+		require.Equal(t, "discovery.attempt", event.Name)
+		for _, attr := range event.Attributes {
+			if attr.Key == "outcome" {
+				outcomes = append(outcomes, attr.Value.AsString())
+			}
+		}
+	}
+	assert.Equal(t, []string{"failure", "success"}, outcomes)
+}
+
+func TestIsA2AConnectionError(t *testing.T) {
+	assert.False(t, isA2AConnectionError(nil))
+	assert.False(t, isA2AConnectionError(errors.New("task failed")))
+	assert.False(t, isA2AConnectionError(&A2ATaskRejectedError{Reason: "connection refused by policy"}))
+	assert.True(t, isA2AConnectionError(&net.OpError{Op: "dial", Err: errors.New("boom")}))
+	assert.True(t, isA2AConnectionError(errors.New("dial tcp 10.0.0.1:80: connect: connection refused")))
+}
+
+func TestOrderA2AAddresses(t *testing.T) {
+	assert.Equal(t, []string{"http://a"}, orderA2AAddresses("http://a", nil))
+	assert.Equal(t,
+		[]string{"http://b", "http://a", "http://c"},
+		orderA2AAddresses("http://b", []string{"http://a", "http://b", "", "http://c"}))
+}
+
+func TestExecuteA2AAgentWithParts(t *testing.T) {
+	var kinds []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.NotFound(w, r)
+			return
+		}
+		var req struct {
+			ID     string `json:"id"`
+			Params struct {
+				Message struct {
+					Parts []struct {
+						Kind string `json:"kind"`
+					} `json:"parts"`
+				} `json:"message"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		for _, part := range req.Params.Message.Parts {
+			kinds = append(kinds, part.Kind)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"a cat"}]}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	card := &A2AAgentCard{DefaultInputModes: []string{"text/plain", "image/png"}}
+	parts := []protocol.Part{
+		protocol.NewTextPart("describe this"),
+		protocol.NewFilePartWithBytes("cat.png", "image/png", "aGVsbG8="),
+	}
+	response, err := ExecuteA2AAgentWithParts(context.Background(), nil, srv.URL, nil, "default", parts, "agent", card, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a cat", response)
+	assert.Equal(t, []string{"text", "file"}, kinds)
+
+	_, err = ExecuteA2AAgentWithParts(context.Background(), nil, srv.URL, nil, "default", []protocol.Part{protocol.NewDataPart(1)}, "agent", card, nil, nil)
+	require.Error(t, err)
+	assert.Len(t, kinds, 2, "unsupported input is rejected before calling the agent")
+}
+
+func TestA2AMessageParts(t *testing.T) {
+	assert.Equal(t, []protocol.Part{protocol.NewTextPart("hello")}, a2aMessageParts(NewUserMessage("hello")))
+
+	input := openai.UserMessage([]openai.ChatCompletionContentPartUnionParam{
+		openai.TextContentPart("what is in these?"),
+		openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: "https://example.com/images/cat.png"}),
+		openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: "data:image/jpeg;base64,aGVsbG8="}),
+		openai.FileContentPart(openai.ChatCompletionContentPartFileFileParam{
+			Filename: openai.String("report.pdf"),
+			FileData: openai.String("data:application/pdf;base64,cGRm"),
+		}),
+		openai.FileContentPart(openai.ChatCompletionContentPartFileFileParam{FileID: openai.String("file-123")}),
+		openai.InputAudioContentPart(openai.ChatCompletionContentPartInputAudioInputAudioParam{Data: "d2F2", Format: "wav"}),
+	})
+
+	assert.Equal(t, []protocol.Part{
+		protocol.NewTextPart("what is in these?"),
+		protocol.NewFilePartWithURI("cat.png", "image/png", "https://example.com/images/cat.png"),
+		protocol.NewFilePartWithBytes("", "image/jpeg", "aGVsbG8="),
+		protocol.NewFilePartWithBytes("report.pdf", "application/pdf", "cGRm"),
+		protocol.NewFilePartWithBytes("", "audio/wav", "d2F2"),
+	}, a2aMessageParts(Message(input)))
+}
+
+func shortenA2ADiscoveryBackoff(t *testing.T) {
+	t.Helper()
+	previous := a2aDiscoveryBaseBackoff
+	a2aDiscoveryBaseBackoff = time.Millisecond
+	t.Cleanup(func() { a2aDiscoveryBaseBackoff = previous })
+}
+
+func TestDiscoverA2AAgentsRetriesTransientFailures(t *testing.T) {
+	shortenA2ADiscoveryBackoff(t)
+
+	var requests []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		switch {
+		case r.URL.Path == "/flaky"+AgentCardPathVersion3 && len(requests) < 2:
+			status = http.StatusServiceUnavailable
+		case strings.HasPrefix(r.URL.Path, "/missing"):
+			status = http.StatusNotFound
+		}
+		requests = append(requests, status)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"name":"weather-agent","url":"http://example"}`))
+	}))
+	defer srv.Close()
+
+	recorder := record.NewFakeRecorder(20)
+	agentCard, err := DiscoverA2AAgentsWithRecorder(context.Background(), nil, srv.URL+"/flaky", nil, "default", recorder, &arkv1prealpha1.A2AServer{})
+	require.NoError(t, err)
+	assert.Equal(t, "weather-agent", agentCard.Name)
+	assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, requests)
+
+	var retries int
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, "A2ADiscoveryRetry") {
+			retries++
+		}
+	}
+	assert.Equal(t, 2, retries)
+
+	// A missing endpoint is an answer: each endpoint is asked once
+	requests = nil
+	_, err = DiscoverA2AAgentsWithRecorder(context.Background(), nil, srv.URL+"/missing", nil, "default", nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, []int{http.StatusNotFound, http.StatusNotFound}, requests)
+}
+
+func TestDiscoverA2AAgentsUsesDiscoveryTimeout(t *testing.T) {
+	shortenA2ADiscoveryBackoff(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	assert.Equal(t, DefaultA2ADiscoveryTimeout, a2aDiscoveryTimeoutFrom(context.Background()))
+	assert.Equal(t, DefaultA2ADiscoveryTimeout, a2aDiscoveryTimeoutFrom(WithA2ADiscoveryTimeout(context.Background(), nil)))
+
+	ctx := WithA2ADiscoveryTimeout(context.Background(), &metav1.Duration{Duration: 50 * time.Millisecond})
+	start := time.Now()
+	_, err := DiscoverA2AAgents(ctx, nil, srv.URL, nil, "default")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestA2AAgentRecordsTaskRejectedOnServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"task","id":"t1","contextId":"c1",
+			"status":{"state":"rejected","message":{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"out of scope"}]}}}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	events := record.NewFakeRecorder(20)
+	recorder := NewTokenUsageCollector(NewQueryRecorder(&arkv1alpha1.Query{ObjectMeta: metav1.ObjectMeta{Name: "q", Namespace: "default"}}, events))
+	agent := newTestA2AAgent(t, recorder, srv.URL)
+
+	_, err := agent.Execute(context.Background(), NewUserMessage("hi"), nil, nil, nil)
+	var rejected *A2ATaskRejectedError
+	require.ErrorAs(t, err, &rejected)
+
+	var reasons []string
+	for len(events.Events) > 0 {
+		reasons = append(reasons, strings.Fields(<-events.Events)[1])
+	}
+	assert.Contains(t, reasons, "A2ATaskRejected")
+}
+
+func TestDiscoverA2AAgentsBoundsRetries(t *testing.T) {
+	shortenA2ADiscoveryBackoff(t)
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	// Each attempt times out, and the retries stop at twice the discovery timeout
+	ctx := WithA2ADiscoveryTimeout(context.Background(), &metav1.Duration{Duration: 200 * time.Millisecond})
+	start := time.Now()
+	_, err := DiscoverA2AAgents(ctx, nil, srv.URL, nil, "default")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 600*time.Millisecond)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestExecuteA2AAgentUsesExecutionTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	assert.Equal(t, context.Background(), WithA2AExecutionTimeout(context.Background(), nil))
+
+	ctx := WithA2AExecutionTimeout(context.Background(), &metav1.Duration{Duration: 100 * time.Millisecond})
+	start := time.Now()
+	_, err := ExecuteA2AAgent(ctx, nil, srv.URL, nil, "default", "hi", "agent")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,151 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/openai/openai-go"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+const a2aToolCallsKey contextKey = "a2aToolCalls"
+
+// a2aToolCallText matches the free-text convention of agents that report tool calls in their history,
+// such as "Executing function `get_coordinates` - result: [32.78306, -96.80667]"
+var a2aToolCallText = regexp.MustCompile("(?s)^\\s*Executing function `([^`]+)`(?: with arguments (.*?))? - result: (.*)$")
+
+// a2aToolCall is a tool call an A2A agent reported making while handling a task
+type a2aToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+	Result    string
+}
+
+// a2aToolCalls accumulates tool calls reported by A2A agents during one execution
+type a2aToolCalls struct {
+	mu    sync.Mutex
+	calls []a2aToolCall
+}
+
+// withA2AToolCalls attaches a tool call accumulator to the context for A2A calls made with it
+func withA2AToolCalls(ctx context.Context) (context.Context, *a2aToolCalls) {
+	calls := &a2aToolCalls{}
+	return context.WithValue(ctx, a2aToolCallsKey, calls), calls
+}
+
+func (c *a2aToolCalls) list() []a2aToolCall {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]a2aToolCall(nil), c.calls...)
+}
+
+// recordA2AToolCalls adds the tool calls found in a task's history to the context's accumulator, if any
+func recordA2AToolCalls(ctx context.Context, task *protocol.Task) {
+	acc, _ := ctx.Value(a2aToolCallsKey).(*a2aToolCalls)
+	if acc == nil || task == nil {
+		return
+	}
+
+	calls := extractA2AToolCalls(task.History)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	for _, call := range calls {
+		if call.ID == "" {
+			call.ID = fmt.Sprintf("%s-tool-%d", task.ID, len(acc.calls))
+		}
+		acc.calls = append(acc.calls, call)
+	}
+}
+
+// extractA2AToolCalls returns the tool calls an agent reported in its messages, either in the
+// structured tool call metadata or in the "Executing function `name` - result: ..." text form
+func extractA2AToolCalls(history []protocol.Message) []a2aToolCall {
+	var calls []a2aToolCall
+	for _, msg := range history {
+		if msg.Role != protocol.MessageRoleAgent {
+			continue
+		}
+		if call, ok := a2aToolCallFromMetadata(msg.Metadata); ok {
+			calls = append(calls, call)
+			continue
+		}
+		if match := a2aToolCallText.FindStringSubmatch(extractTextFromParts(msg.Parts)); match != nil {
+			calls = append(calls, a2aToolCall{
+				Name:      match[1],
+				Arguments: strings.TrimSpace(match[2]),
+				Result:    strings.TrimSpace(match[3]),
+			})
+		}
+	}
+	return calls
+}
+
+func a2aToolCallFromMetadata(metadata map[string]interface{}) (a2aToolCall, bool) {
+	fields, _ := metadata[A2AToolCallMetadataKey].(map[string]interface{})
+	name, _ := fields["name"].(string)
+	if name == "" {
+		return a2aToolCall{}, false
+	}
+	id, _ := fields["id"].(string)
+	return a2aToolCall{
+		ID:        id,
+		Name:      name,
+		Arguments: a2aToolCallValue(fields["arguments"]),
+		Result:    a2aToolCallValue(fields["result"]),
+	}, true
+}
+
+// a2aToolCallValue returns strings as they are and encodes anything else as JSON
+func a2aToolCallValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+// a2aToolCallMessages converts reported tool calls into an assistant tool call message followed by
+// one tool message per result, the same shape native agents produce
+func a2aToolCallMessages(agentName string, calls []a2aToolCall) []Message {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	toolCalls := make([]openai.ChatCompletionMessageToolCallParam, 0, len(calls))
+	for _, call := range calls {
+		arguments := call.Arguments
+		if arguments == "" {
+			arguments = "{}"
+		}
+		toolCalls = append(toolCalls, openai.ChatCompletionMessageToolCallParam{
+			ID: call.ID,
+			Function: openai.ChatCompletionMessageToolCallFunctionParam{
+				Name:      call.Name,
+				Arguments: arguments,
+			},
+		})
+	}
+
+	messages := []Message{{OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+		Name:      openai.String(agentName),
+		ToolCalls: toolCalls,
+	}}}
+	for _, call := range calls {
+		messages = append(messages, ToolMessage(call.Result, call.ID))
+	}
+	return messages
+}
@@ -0,0 +1,94 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+func TestExtractA2AToolCalls(t *testing.T) {
+	history := []protocol.Message{
+		{
+			Role:  protocol.MessageRoleUser,
+			Parts: []protocol.Part{protocol.NewTextPart("Executing function `ignored` - result: user text")},
+		},
+		{
+			Role:  protocol.MessageRoleAgent,
+			Parts: []protocol.Part{protocol.NewTextPart("Executing function `get_coordinates` - result: [32.78306, -96.80667]")},
+		},
+		{
+			Role:  protocol.MessageRoleAgent,
+			Parts: []protocol.Part{protocol.NewTextPart("Executing function `get_forecast` with arguments {\"city\":\"Dallas\"} - result: sunny")},
+		},
+		{
+			Role: protocol.MessageRoleAgent,
+			Metadata: map[string]interface{}{
+				A2AMessageKindMetadataKey: A2AMessageKindToolCall,
+				A2AToolCallMetadataKey: map[string]interface{}{
+					"id":        "call-1",
+					"name":      "get_temperature",
+					"arguments": map[string]interface{}{"unit": "C"},
+					"result":    map[string]interface{}{"temperature": float64(25)},
+				},
+			},
+			Parts: []protocol.Part{protocol.NewTextPart("Checking the temperature")},
+		},
+		{
+			Role:  protocol.MessageRoleAgent,
+			Parts: []protocol.Part{protocol.NewTextPart("It is sunny in Dallas")},
+		},
+	}
+
+	assert.Equal(t, []a2aToolCall{
+		{Name: "get_coordinates", Result: "[32.78306, -96.80667]"},
+		{Name: "get_forecast", Arguments: `{"city":"Dallas"}`, Result: "sunny"},
+		{ID: "call-1", Name: "get_temperature", Arguments: `{"unit":"C"}`, Result: `{"temperature":25}`},
+	}, extractA2AToolCalls(history))
+}
+
+func TestA2AAgentReturnsToolCallMessages(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"task","id":"t1","contextId":"c1",
+			"status":{"state":"completed"},
+			"history":[
+				{"kind":"message","messageId":"m1","role":"agent","metadata":{"ark.mckinsey.com/message-kind":"tool-call"},
+					"parts":[{"kind":"text","text":"Executing function `+"`get_coordinates`"+` - result: [32.78306, -96.80667]"}]},
+				{"kind":"message","messageId":"m2","role":"agent","parts":[{"kind":"text","text":"It is sunny in Dallas"}]}]}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	remote := newTestA2AAgent(t, &mockRecorder{}, srv.URL)
+	messages, err := remote.Execute(context.Background(), NewUserMessage("weather in Dallas?"), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+
+	require.NotNil(t, messages[0].OfAssistant)
+	require.Len(t, messages[0].OfAssistant.ToolCalls, 1)
+	toolCall := messages[0].OfAssistant.ToolCalls[0]
+	assert.Equal(t, "t1-tool-0", toolCall.ID)
+	assert.Equal(t, "get_coordinates", toolCall.Function.Name)
+	assert.Equal(t, "{}", toolCall.Function.Arguments)
+
+	require.NotNil(t, messages[1].OfTool)
+	assert.Equal(t, "t1-tool-0", messages[1].OfTool.ToolCallID)
+	assert.Equal(t, "[32.78306, -96.80667]", messages[1].OfTool.Content.OfString.Value)
+
+	require.NotNil(t, messages[2].OfAssistant)
+	assert.Equal(t, "It is sunny in Dallas", messages[2].OfAssistant.Content.OfString.Value)
+}
@@ -3,7 +3,13 @@
 package genai
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
 	"trpc.group/trpc-go/trpc-a2a-go/server"
+
+	"mckinsey.com/ark/internal/telemetry"
 )
 
 // ExecutionEngineA2A is the reserved name for A2A execution engine
@@ -21,7 +27,99 @@ const (
 	TaskStateAuthRequired  = "auth-required"
 )
 
+// A2A agents mark intermediate reasoning or progress messages with this metadata key so Ark
+// keeps them in task history but leaves them out of the response
+const (
+	A2AMessageKindMetadataKey = "ark.mckinsey.com/message-kind"
+	A2AMessageKindReasoning   = "reasoning"
+	A2AMessageKindToolCall    = "tool-call"
+	// A2AToolCallMetadataKey holds a tool call made by the agent as {"id", "name", "arguments", "result"}
+	A2AToolCallMetadataKey = "ark.mckinsey.com/tool-call"
+)
+
 // Use the official A2A library types
 type (
 	A2AAgentCard = server.AgentCard
+	A2ASkill     = server.AgentSkill
 )
+
+// A2AJSONRPCRequest is a raw JSON-RPC 2.0 request sent to an A2A server
+type A2AJSONRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      string `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+// A2AJSONRPCResponse is a raw JSON-RPC 2.0 response returned by an A2A server
+type A2AJSONRPCResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      string           `json:"id"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *A2AJSONRPCError `json:"error,omitempty"`
+}
+
+// A2AJSONRPCError is the error object of a JSON-RPC 2.0 response
+type A2AJSONRPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *A2AJSONRPCError) Error() string {
+	return fmt.Sprintf("A2A JSON-RPC error %d: %s", e.Code, e.Message)
+}
+
+// ErrorType classifies standard JSON-RPC request errors as invalid requests and everything else,
+// including internal and A2A-specific errors, as server errors
+func (e *A2AJSONRPCError) ErrorType() string {
+	if e.Code <= -32600 && e.Code >= -32700 && e.Code != -32603 {
+		return telemetry.ErrorTypeInvalidRequest
+	}
+	return telemetry.ErrorTypeServer
+}
+
+// A2AStatusError is returned when an A2A server answers a JSON-RPC call with a non-200 HTTP status
+type A2AStatusError struct {
+	StatusCode int
+	Method     string
+}
+
+func (e *A2AStatusError) Error() string {
+	return fmt.Sprintf("A2A server returned status %d for method %s", e.StatusCode, e.Method)
+}
+
+// ErrorType classifies rate limits and server errors as server errors, so callers such as team
+// retries treat them as transient
+func (e *A2AStatusError) ErrorType() string {
+	if e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500 {
+		return telemetry.ErrorTypeServer
+	}
+	return telemetry.ErrorTypeInvalidRequest
+}
+
+// A2ATaskRejectedError is returned when an A2A agent refuses a task rather than attempting it and failing
+type A2ATaskRejectedError struct {
+	Reason string
+}
+
+func (e *A2ATaskRejectedError) Error() string {
+	return fmt.Sprintf("task rejected by agent: %s", e.Reason)
+}
+
+func (e *A2ATaskRejectedError) ErrorType() string {
+	return telemetry.ErrorTypeInvalidRequest
+}
+
+// A2ACapabilityError is returned when an agent card does not advertise what a call needs
+type A2ACapabilityError struct {
+	Message string
+}
+
+func (e *A2ACapabilityError) Error() string {
+	return e.Message
+}
+
+func (e *A2ACapabilityError) ErrorType() string {
+	return telemetry.ErrorTypeInvalidRequest
+}
@@ -0,0 +1,105 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
+
+const a2aTokenUsageKey contextKey = "a2aTokenUsage"
+
+// a2aTokenUsage accumulates token usage reported by A2A agents during one execution
+type a2aTokenUsage struct {
+	mu    sync.Mutex
+	usage TokenUsage
+}
+
+// withA2ATokenUsage attaches a token usage accumulator to the context for A2A calls made with it
+func withA2ATokenUsage(ctx context.Context) (context.Context, *a2aTokenUsage) {
+	usage := &a2aTokenUsage{}
+	return context.WithValue(ctx, a2aTokenUsageKey, usage), usage
+}
+
+func (u *a2aTokenUsage) add(usage TokenUsage) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.usage.PromptTokens += usage.PromptTokens
+	u.usage.CompletionTokens += usage.CompletionTokens
+	u.usage.TotalTokens += usage.TotalTokens
+}
+
+func (u *a2aTokenUsage) total() TokenUsage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.usage
+}
+
+// recordA2ATokenUsage adds usage found in A2A result metadata to the context's accumulator, if any
+func recordA2ATokenUsage(ctx context.Context, metadata map[string]interface{}) {
+	acc, _ := ctx.Value(a2aTokenUsageKey).(*a2aTokenUsage)
+	if acc == nil {
+		return
+	}
+	if usage := extractA2ATokenUsage(metadata); usage.TotalTokens > 0 {
+		acc.add(usage)
+	}
+}
+
+// a2aResultMetadata returns the metadata of a message/send result
+func a2aResultMetadata(result *protocol.MessageResult) map[string]interface{} {
+	switch r := result.Result.(type) {
+	case *protocol.Message:
+		return r.Metadata
+	case *protocol.Task:
+		return r.Metadata
+	default:
+		return nil
+	}
+}
+
+// extractA2ATokenUsage reads token usage that agents report in metadata, either nested under
+// "usage" or as flat "usage.<name>" keys. Both input/output and prompt/completion names are accepted.
+func extractA2ATokenUsage(metadata map[string]interface{}) TokenUsage {
+	lookup := func(names ...string) int64 {
+		nested, _ := metadata["usage"].(map[string]interface{})
+		for _, name := range names {
+			if value, ok := a2aTokenCount(nested[name]); ok {
+				return value
+			}
+			if value, ok := a2aTokenCount(metadata["usage."+name]); ok {
+				return value
+			}
+		}
+		return 0
+	}
+
+	usage := TokenUsage{
+		PromptTokens:     lookup("input_tokens", "prompt_tokens"),
+		CompletionTokens: lookup("output_tokens", "completion_tokens"),
+		TotalTokens:      lookup("total_tokens"),
+	}
+	if usage.TotalTokens == 0 {
+		usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+	}
+	return usage
+}
+
+func a2aTokenCount(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
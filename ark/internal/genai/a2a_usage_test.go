@@ -0,0 +1,132 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+	arkann "mckinsey.com/ark/internal/annotations"
+)
+
+func TestExtractA2ATokenUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		expected TokenUsage
+	}{
+		{
+			name:     "no metadata",
+			expected: TokenUsage{},
+		},
+		{
+			name: "nested input and output tokens",
+			metadata: map[string]interface{}{
+				"usage": map[string]interface{}{"input_tokens": float64(12), "output_tokens": float64(30)},
+			},
+			expected: TokenUsage{PromptTokens: 12, CompletionTokens: 30, TotalTokens: 42},
+		},
+		{
+			name: "flat prompt and completion tokens with total",
+			metadata: map[string]interface{}{
+				"usage.prompt_tokens":     float64(5),
+				"usage.completion_tokens": float64(7),
+				"usage.total_tokens":      float64(15),
+			},
+			expected: TokenUsage{PromptTokens: 5, CompletionTokens: 7, TotalTokens: 15},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, extractA2ATokenUsage(tt.metadata))
+		})
+	}
+}
+
+func TestA2AExecutionAccumulatesTokenUsage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"task","id":"t1","contextId":"c1",
+			"status":{"state":"completed"},
+			"history":[{"kind":"message","messageId":"m1","role":"agent","parts":[{"kind":"text","text":"done"}]}],
+			"metadata":{"usage":{"input_tokens":10,"output_tokens":4}}}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	ctx, usage := withA2ATokenUsage(context.Background())
+	for range 2 {
+		response, err := ExecuteA2AAgent(ctx, nil, srv.URL, nil, "default", "hi", "agent")
+		require.NoError(t, err)
+		assert.Equal(t, "done", response)
+	}
+	assert.Equal(t, TokenUsage{PromptTokens: 20, CompletionTokens: 8, TotalTokens: 28}, usage.total())
+}
+
+func TestA2ATeamMemberTokenUsage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req A2AJSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"kind":"message","messageId":"m1","role":"agent",
+			"parts":[{"kind":"text","text":"done"}],"metadata":{"usage":{"input_tokens":10,"output_tokens":4}}}}`, req.ID)
+	}))
+	defer srv.Close()
+
+	recorder := NewTokenUsageCollector(&mockRecorder{})
+	remote := newTestA2AAgent(t, recorder, srv.URL)
+	team := &Team{Name: "team", Namespace: "default", Strategy: "sequential", Members: []TeamMember{remote}, Recorder: recorder}
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hi"), nil, nil, nil)
+	require.NoError(t, err)
+
+	expected := TokenUsage{PromptTokens: 10, CompletionTokens: 4, TotalTokens: 14}
+	require.Len(t, team.Run().Turns, 1)
+	assert.Equal(t, &expected, team.Run().Turns[0].TokenUsage)
+	assert.Equal(t, &expected, team.Run().TokenUsage)
+	assert.Equal(t, expected, recorder.GetTokenSummary())
+
+	completed, ok := recorder.recorder.(*mockRecorder).eventFor("TeamExecutionComplete").(OperationEvent)
+	require.True(t, ok)
+	assert.Equal(t, expected, completed.TokenUsage)
+}
+
+// newTestA2AAgent returns an agent backed by an A2AServer at the address, as created by A2A discovery
+func newTestA2AAgent(t *testing.T, recorder EventEmitter, address string) *Agent {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, arkv1prealpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&arkv1prealpha1.A2AServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "default"},
+	}).Build()
+
+	return &Agent{
+		Name:            "remote-agent",
+		Namespace:       "default",
+		Recorder:        recorder,
+		ExecutionEngine: &arkv1alpha1.ExecutionEngineRef{Name: ExecutionEngineA2A},
+		Annotations: map[string]string{
+			arkann.A2AServerName:    "remote",
+			arkann.A2AServerAddress: address,
+		},
+		client: k8sClient,
+	}
+}
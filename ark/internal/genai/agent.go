@@ -169,24 +169,30 @@ func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatComplet
 	defer toolSpan.End()
 
 	result, err := a.Tools.ExecuteTool(ctx, ToolCall(toolCall), a.Recorder)
-	toolMessage := ToolMessage(result.Content, result.ID)
+	toolMessage := ToolMessage(result.MessageContent(), result.ID)
 
 	if err != nil {
-		telemetry.RecordToolError(toolSpan, err)
 		if IsTerminateTeam(err) {
+			telemetry.RecordToolSuccess(toolSpan, result.Content)
 			toolTracker.CompleteWithTermination(err.Error())
 		} else {
+			telemetry.RecordToolError(toolSpan, err)
 			toolTracker.Fail(err)
 		}
 		return toolMessage, err
 	}
 
 	telemetry.RecordToolSuccess(toolSpan, result.Content)
-	toolTracker.CompleteWithMetadata(result.Content, map[string]string{
+	metadata := map[string]string{
 		"resultLength": fmt.Sprintf("%d", len(result.Content)),
 		"hasError":     "false",
 		"resultId":     result.ID,
-	})
+	}
+	// Tool messages only carry text, so attachments reach the model as the descriptions in Content
+	if len(result.Attachments) > 0 {
+		metadata["attachments"] = fmt.Sprintf("%d", len(result.Attachments))
+	}
+	toolTracker.CompleteWithMetadata(result.Content, metadata)
 	return toolMessage, nil
 }
 
@@ -315,6 +321,8 @@ func MakeAgent(ctx context.Context, k8sClient client.Client, crd *arkv1alpha1.Ag
 		return nil, fmt.Errorf("failed to make query from context for agent %s/%s: %w", crd.Namespace, crd.Name, err)
 	}
 	tools := NewToolRegistry(query.McpSettings)
+	// The tool registry's MCP sessions are closed with the query's other resources
+	RegisterCloser(ctx, tools)
 
 	if err := tools.registerTools(ctx, k8sClient, crd); err != nil {
 		return nil, err
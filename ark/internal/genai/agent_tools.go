@@ -34,8 +34,8 @@ func (p *MCPClientPool) GetOrCreateClient(ctx context.Context, serverName, serve
 	// Get MCP settings for this server if available
 	mcpSetting := mcpSettings[key]
 
-	// Create new client for this MCP server
-	mcpClient, err := NewMCPClient(ctx, serverURL, headers, transport, timeout, mcpSetting)
+	// Sessions are shared with other registries through the process-wide session pool
+	mcpClient, err := defaultMCPSessionPool.acquire(ctx, serverURL, headers, transport, timeout, mcpSetting)
 	if err != nil {
 		return nil, err
 	}
@@ -44,12 +44,12 @@ func (p *MCPClientPool) GetOrCreateClient(ctx context.Context, serverName, serve
 	return mcpClient, nil
 }
 
-// Close closes all MCP client connections in the pool
+// Close gives the pool's MCP sessions back to the session pool, which closes them once they are idle
 func (p *MCPClientPool) Close() error {
 	var lastErr error
 	for key, mcpClient := range p.clients {
-		if mcpClient != nil && mcpClient.client != nil {
-			if err := mcpClient.client.Close(); err != nil {
+		if mcpClient != nil {
+			if err := defaultMCPSessionPool.release(mcpClient); err != nil {
 				lastErr = fmt.Errorf("failed to close MCP client %s: %w", key, err)
 			}
 		}
@@ -168,7 +168,7 @@ func createMCPExecutor(ctx context.Context, k8sClient client.Client, tool *arkv1
 
 	// Use the MCP client pool to get or create the client
 	mcpClient, err := mcpPool.GetOrCreateClient(
-		ctx,
+		WithMCPConnectRetry(ctx, mcpServerCRD.Spec.ConnectRetry),
 		tool.Spec.MCP.MCPServerRef.Name,
 		mcpServerNamespace,
 		mcpURL,
@@ -182,8 +182,10 @@ func createMCPExecutor(ctx context.Context, k8sClient client.Client, tool *arkv1
 	}
 
 	return &MCPExecutor{
-		ToolName:  tool.Spec.MCP.ToolName,
-		MCPClient: mcpClient,
+		ToolName:     tool.Spec.MCP.ToolName,
+		MCPClient:    mcpClient,
+		CacheResults: tool.Spec.MCP.CacheResults,
+		ToolFilter:   mcpServerCRD.Spec.ToolFilter,
 	}, nil
 }
 
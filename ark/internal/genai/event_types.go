@@ -4,10 +4,14 @@ package genai
 
 import "context"
 
+// EventEmitter receives the execution events of agents, teams, tools and models. eventType is a
+// Kubernetes event type (Normal or Warning) and reason names the event, such as TeamTurnStart.
+// Implementations include the query's TokenUsageCollector and, in tests, genaitest.Recorder.
 type EventEmitter interface {
 	EmitEvent(ctx context.Context, eventType, reason string, data EventData)
 }
 
+// EventData is the payload of an event, such as BaseEvent, ExecutionEvent or OperationEvent
 type EventData interface {
 	ToMap() map[string]interface{}
 }
@@ -120,7 +120,8 @@ func convertToExecutionEngineMessage(msg Message) ExecutionEngineMessage {
 
 // convertFromExecutionEngineMessage converts ExecutionEngineMessage back to internal genai.Message format
 func convertFromExecutionEngineMessage(msg ExecutionEngineMessage) Message {
-	switch msg.Role {
+	role, _ := NormalizeRole(msg.Role)
+	switch role {
 	case RoleUser:
 		return NewUserMessage(msg.Content)
 	case RoleAssistant:
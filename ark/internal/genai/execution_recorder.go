@@ -5,6 +5,7 @@ package genai
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -62,6 +63,36 @@ func (r *ExecutionRecorder) TeamTurn(ctx context.Context, phase, teamName, strat
 	r.emitter.EmitEvent(ctx, corev1.EventTypeNormal, "TeamTurn"+phase, event)
 }
 
+func (r *ExecutionRecorder) GraphPath(ctx context.Context, teamName string, path, edges []string) {
+	event := ExecutionEvent{
+		BaseEvent: BaseEvent{
+			Name: teamName,
+			Metadata: map[string]string{
+				"path":  strings.Join(path, " -> "),
+				"edges": strings.Join(edges, ","),
+				"steps": fmt.Sprintf("%d", len(path)),
+			},
+		},
+		Type: "team",
+	}
+	r.emitter.EmitEvent(ctx, corev1.EventTypeNormal, "TeamGraphPath", event)
+}
+
+func (r *ExecutionRecorder) GraphEdgeSkipped(ctx context.Context, teamName, from, to, reason string) {
+	event := ExecutionEvent{
+		BaseEvent: BaseEvent{
+			Name: teamName,
+			Metadata: map[string]string{
+				"from":   from,
+				"to":     to,
+				"reason": reason,
+			},
+		},
+		Type: "team",
+	}
+	r.emitter.EmitEvent(ctx, corev1.EventTypeNormal, "TeamGraphEdgeSkipped", event)
+}
+
 func (r *ExecutionRecorder) AgentExecution(ctx context.Context, phase, agentName, modelName string) {
 	event := ExecutionEvent{
 		BaseEvent: BaseEvent{
@@ -0,0 +1,80 @@
+/* Copyright 2025. McKinsey & Company */
+
+// Package genaitest provides fakes for testing code built on the genai package without a cluster.
+package genaitest
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"mckinsey.com/ark/internal/genai"
+)
+
+// Event is an event captured by Recorder
+type Event struct {
+	Type   string
+	Reason string
+	Data   genai.EventData
+}
+
+// Recorder is a genai.EventEmitter that keeps every emitted event, in order, so tests can assert
+// on exactly what a team, agent or tool emitted. It is safe for concurrent use.
+type Recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewRecorder returns an empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) EmitEvent(_ context.Context, eventType, reason string, data genai.EventData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, Event{Type: eventType, Reason: reason, Data: data})
+}
+
+// Events returns all recorded events
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+// Reasons returns the reasons of the recorded events. When reasons are given, only events with
+// one of those reasons are included, which keeps assertions independent of unrelated events.
+func (r *Recorder) Reasons(reasons ...string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []string
+	for _, event := range r.events {
+		if len(reasons) == 0 || slices.Contains(reasons, event.Reason) {
+			result = append(result, event.Reason)
+		}
+	}
+	return result
+}
+
+// EventsFor returns the recorded events with the given reason
+func (r *Recorder) EventsFor(reason string) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []Event
+	for _, event := range r.events {
+		if event.Reason == reason {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
+// Reset drops all recorded events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
@@ -2,7 +2,9 @@ package genai
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -33,7 +35,7 @@ type MCPClient struct {
 }
 
 func NewMCPClient(ctx context.Context, baseURL string, headers map[string]string, transportType string, timeout time.Duration, mcpSetting MCPSettings) (*MCPClient, error) {
-	mcpClient, err := createMCPClientWithRetry(ctx, baseURL, headers, transportType, timeout, 5, 120*time.Second)
+	mcpClient, err := createMCPClientWithRetry(ctx, baseURL, headers, transportType, timeout, mcpConnectLimitsFrom(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +62,7 @@ func createHTTPClient() (*mcp.Client, error) {
 		Version: arkv1alpha1.GroupVersion.Version,
 	}
 
-	mcpClient := mcp.NewClient(impl, nil)
+	mcpClient := mcp.NewClient(impl, newMCPClientOptions())
 	return mcpClient, nil
 }
 
@@ -75,9 +77,9 @@ func createMCPClientByTransport(transportType string) (*mcp.Client, error) {
 	}
 }
 
-func performBackoff(ctx context.Context, attempt int, baseURL string) error {
+// performBackoff waits before the next connection attempt
+func performBackoff(ctx context.Context, attempt int, baseURL string, backoff time.Duration) error {
 	log := logf.FromContext(ctx)
-	backoff := time.Duration(1<<uint(attempt)) * time.Second
 	log.Info("retrying MCP client connection", "attempt", attempt+1, "backoff", backoff.String(), "server", baseURL)
 
 	select {
@@ -88,18 +90,15 @@ func performBackoff(ctx context.Context, attempt int, baseURL string) error {
 	}
 }
 
-func createTransport(baseURL string, headers map[string]string, timeout time.Duration) mcp.Transport {
-	// Create HTTP client with headers
+func createTransport(baseURL string, headers map[string]string, timeout time.Duration, status *mcpHTTPStatus) mcp.Transport {
+	// Create HTTP client with the user agent and headers
 	httpClient := &http.Client{
 		Timeout: timeout,
-	}
-
-	// If we have headers, wrap the transport
-	if len(headers) > 0 {
-		httpClient.Transport = &headerTransport{
+		Transport: &headerTransport{
 			headers: headers,
 			base:    http.DefaultTransport,
-		}
+			status:  status,
+		},
 	}
 
 	u, _ := url.Parse(baseURL)
@@ -116,21 +115,29 @@ func createTransport(baseURL string, headers map[string]string, timeout time.Dur
 type headerTransport struct {
 	headers map[string]string
 	base    http.RoundTripper
+	status  *mcpHTTPStatus
 }
 
 func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	setUserAgent(req.Header)
 	for k, v := range t.headers {
 		req.Header.Set(k, v)
 	}
-	return t.base.RoundTrip(req)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		t.status.record(resp)
+	}
+	return resp, err
 }
 
 func attemptMCPConnection(ctx, connectCtx context.Context, mcpClient *mcp.Client, baseURL string, headers map[string]string, httpTimeout time.Duration) (*mcp.ClientSession, error) {
 	log := logf.FromContext(ctx)
 
-	transport := createTransport(baseURL, headers, httpTimeout)
+	status := &mcpHTTPStatus{}
+	transport := createTransport(baseURL, headers, httpTimeout, status)
 	session, err := mcpClient.Connect(connectCtx, transport, nil)
 	if err != nil {
+		err = status.wrap(err)
 		if isRetryableError(err) {
 			log.V(1).Info("retryable error connecting MCP client", "error", err)
 			return nil, err
@@ -141,7 +148,7 @@ func attemptMCPConnection(ctx, connectCtx context.Context, mcpClient *mcp.Client
 	return session, nil
 }
 
-func createMCPClientWithRetry(ctx context.Context, baseURL string, headers map[string]string, transportType string, httpTimeout time.Duration, maxRetries int, connectTimeout time.Duration) (*MCPClient, error) {
+func createMCPClientWithRetry(ctx context.Context, baseURL string, headers map[string]string, transportType string, httpTimeout time.Duration, limits mcpConnectLimits) (*MCPClient, error) {
 	log := logf.FromContext(ctx)
 
 	mcpClient, err := createMCPClientByTransport(transportType)
@@ -149,14 +156,24 @@ func createMCPClientWithRetry(ctx context.Context, baseURL string, headers map[s
 		return nil, err
 	}
 
-	connectCtx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	// The session outlives the connect context, so cancelling the caller only stops connection attempts
+	start := time.Now()
+	connectCtx, cancel := context.WithTimeout(ctx, limits.maxElapsed)
 	defer cancel()
 
 	var lastErr error
 	var session *mcp.ClientSession
-	for attempt := range maxRetries {
+	for attempt := range limits.maxAttempts {
 		if attempt > 0 {
-			if err := performBackoff(connectCtx, attempt, baseURL); err != nil {
+			if !AllowRetry(ctx, "MCP connection to "+baseURL) {
+				return nil, fmt.Errorf("retry budget exhausted connecting MCP client for %s: %w", baseURL, lastErr)
+			}
+			// A retry that could not start before the deadline is not waited for
+			backoff := limits.backoff(attempt, mcpRetryAfter(lastErr))
+			if elapsed := time.Since(start); elapsed+backoff >= limits.maxElapsed {
+				return nil, fmt.Errorf("gave up connecting MCP client for %s after %s: %w", baseURL, elapsed.Round(time.Millisecond), lastErr)
+			}
+			if err := performBackoff(connectCtx, attempt, baseURL, backoff); err != nil {
 				return nil, err
 			}
 		}
@@ -177,7 +194,7 @@ func createMCPClientWithRetry(ctx context.Context, baseURL string, headers map[s
 		}
 	}
 
-	return nil, fmt.Errorf("failed to create MCP client for %s after %d attempts: %w", baseURL, maxRetries, lastErr)
+	return nil, fmt.Errorf("failed to create MCP client for %s after %d attempts: %w", baseURL, limits.maxAttempts, lastErr)
 }
 
 func isRetryableError(err error) bool {
@@ -185,6 +202,12 @@ func isRetryableError(err error) bool {
 		return false
 	}
 
+	// Rate limits and server errors are worth another attempt, other HTTP errors are not
+	var statusErr *MCPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Retryable()
+	}
+
 	// Check for connection refused errors
 	if netErr, ok := err.(*net.OpError); ok && netErr.Op == "dial" {
 		if syscallErr, ok := netErr.Err.(*net.DNSError); ok && syscallErr.IsTemporary {
@@ -214,19 +237,49 @@ func isRetryableError(err error) bool {
 	return false
 }
 
-func (c *MCPClient) ListTools(ctx context.Context) ([]*mcp.Tool, error) {
+// ListTools returns the server's tools that the filter allows. A nil filter allows every tool.
+func (c *MCPClient) ListTools(ctx context.Context, filter *arkv1alpha1.MCPToolFilter) ([]*mcp.Tool, error) {
 	response, err := c.client.ListTools(ctx, &mcp.ListToolsParams{})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Tools, nil
+	tools := make([]*mcp.Tool, 0, len(response.Tools))
+	for _, tool := range response.Tools {
+		if MCPToolAllowed(filter, tool.Name) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools, nil
+}
+
+// MCPToolAllowed reports whether the filter exposes the tool. Deny patterns take precedence over allow patterns.
+func MCPToolAllowed(filter *arkv1alpha1.MCPToolFilter, toolName string) bool {
+	if filter == nil {
+		return true
+	}
+	if matchesMCPToolPattern(filter.Deny, toolName) {
+		return false
+	}
+	return len(filter.Allow) == 0 || matchesMCPToolPattern(filter.Allow, toolName)
+}
+
+func matchesMCPToolPattern(patterns []string, toolName string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, toolName); err == nil && matched {
+			return true
+		}
+	}
+	return false
 }
 
 // MCP Tool Executor
 type MCPExecutor struct {
-	MCPClient *MCPClient
-	ToolName  string
+	MCPClient    *MCPClient
+	ToolName     string
+	CacheResults bool
+	// ToolFilter is the filter of the tool's MCPServer; tools it does not allow are never called
+	ToolFilter *arkv1alpha1.MCPToolFilter
 }
 
 func (m *MCPExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
@@ -235,13 +288,19 @@ func (m *MCPExecutor) Execute(ctx context.Context, call ToolCall, recorder Event
 	if m.MCPClient == nil {
 		err := fmt.Errorf("MCP client not initialized for tool %s", m.ToolName)
 		log.Error(err, "MCP client is nil")
-		return ToolResult{ID: call.ID, Name: call.Function.Name, Content: ""}, err
+		return ToolResult{ID: call.ID, Name: call.Function.Name, Error: err.Error()}, err
 	}
 
 	if m.MCPClient.client == nil {
 		err := fmt.Errorf("MCP client connection not initialized for tool %s", m.ToolName)
 		log.Error(err, "MCP client connection is nil")
-		return ToolResult{ID: call.ID, Name: call.Function.Name, Content: ""}, err
+		return ToolResult{ID: call.ID, Name: call.Function.Name, Error: err.Error()}, err
+	}
+
+	if !MCPToolAllowed(m.ToolFilter, m.ToolName) {
+		err := fmt.Errorf("tool %s is not allowed by the tool filter of MCP server %s", m.ToolName, m.MCPClient.baseURL)
+		log.Info("refusing mcp tool call", "tool", m.ToolName, "server", m.MCPClient.baseURL)
+		return ToolResult{ID: call.ID, Name: call.Function.Name, Error: err.Error()}, err
 	}
 
 	var arguments map[string]any
@@ -250,53 +309,105 @@ func (m *MCPExecutor) Execute(ctx context.Context, call ToolCall, recorder Event
 		arguments = make(map[string]any)
 	}
 
+	cache, cacheKey := m.resultCache(ctx, arguments)
+	if cache != nil {
+		if content, ok := cache.get(cacheKey); ok {
+			log.Info("serving mcp tool result from cache", "tool", m.ToolName, "server", m.MCPClient.baseURL)
+			return ToolResult{ID: call.ID, Name: call.Function.Name, Content: content}, nil
+		}
+	}
+
 	log.Info("calling mcp", "tool", m.ToolName, "server", m.MCPClient.baseURL)
-	response, err := m.MCPClient.client.CallTool(ctx, &mcp.CallToolParams{
+	// Meta must be non-nil for SetProgressToken to take effect
+	params := &mcp.CallToolParams{
+		Meta:      mcp.Meta{},
 		Name:      m.ToolName,
 		Arguments: arguments,
-	})
+	}
+	progressToken, progressContent := trackMCPProgress()
+	params.SetProgressToken(progressToken)
+	response, err := m.MCPClient.client.CallTool(ctx, params)
+	partial := progressContent()
 	if err != nil {
 		log.Info("tool call error", "tool", m.ToolName, "error", err, "errorType", fmt.Sprintf("%T", err))
-		return ToolResult{ID: call.ID, Name: call.Function.Name, Content: ""}, err
+		if partial != "" && errors.Is(err, context.DeadlineExceeded) {
+			log.Info("returning partial tool result received before timeout", "tool", m.ToolName, "length", len(partial))
+			return ToolResult{ID: call.ID, Name: call.Function.Name, Content: partial, Error: err.Error(), Partial: true}, err
+		}
+		return ToolResult{ID: call.ID, Name: call.Function.Name, Error: err.Error()}, err
 	}
 	log.V(2).Info("tool call response", "tool", m.ToolName, "response", response)
+	content, attachments := mcpResultContent(response.Content)
+	if response.IsError {
+		// The tool reported the failure itself; the model sees its content, Error marks it for callers
+		return ToolResult{ID: call.ID, Name: call.Function.Name, Content: content, Error: content, Attachments: attachments}, nil
+	}
+	// The cache only holds text, so results with attachments are not cached
+	if cache != nil && len(attachments) == 0 {
+		cache.put(cacheKey, content)
+	}
+	return ToolResult{ID: call.ID, Name: call.Function.Name, Content: content, Attachments: attachments}, nil
+}
+
+// mcpResultContent flattens MCP content into text and returns images, audio and resources as attachments.
+// Each attachment is described in the text where it appeared, so the model knows it was returned.
+func mcpResultContent(contents []mcp.Content) (string, []ToolResultAttachment) {
 	var result strings.Builder
-	for _, content := range response.Content {
-		if textContent, ok := content.(*mcp.TextContent); ok {
-			result.WriteString(textContent.Text)
-		} else {
+	var attachments []ToolResultAttachment
+	for _, content := range contents {
+		switch c := content.(type) {
+		case *mcp.TextContent:
+			result.WriteString(c.Text)
+		case *mcp.ImageContent:
+			attachments = append(attachments, ToolResultAttachment{Type: "image", MimeType: c.MIMEType, Data: base64.StdEncoding.EncodeToString(c.Data)})
+			fmt.Fprintf(&result, "[image: %s, %d bytes]", c.MIMEType, len(c.Data))
+		case *mcp.AudioContent:
+			attachments = append(attachments, ToolResultAttachment{Type: "audio", MimeType: c.MIMEType, Data: base64.StdEncoding.EncodeToString(c.Data)})
+			fmt.Fprintf(&result, "[audio: %s, %d bytes]", c.MIMEType, len(c.Data))
+		case *mcp.ResourceLink:
+			attachments = append(attachments, ToolResultAttachment{Type: "resource", MimeType: c.MIMEType, URI: c.URI})
+			fmt.Fprintf(&result, "[resource: %s]", c.URI)
+		case *mcp.EmbeddedResource:
+			if c.Resource == nil {
+				continue
+			}
+			// Text resources stay readable for the model; binary ones are only described
+			if c.Resource.Blob == nil {
+				attachments = append(attachments, ToolResultAttachment{Type: "resource", MimeType: c.Resource.MIMEType, URI: c.Resource.URI})
+				result.WriteString(c.Resource.Text)
+				continue
+			}
+			attachments = append(attachments, ToolResultAttachment{Type: "resource", MimeType: c.Resource.MIMEType, URI: c.Resource.URI, Data: base64.StdEncoding.EncodeToString(c.Resource.Blob)})
+			fmt.Fprintf(&result, "[resource: %s, %s, %d bytes]", c.Resource.URI, c.Resource.MIMEType, len(c.Resource.Blob))
+		default:
 			jsonBytes, _ := json.MarshalIndent(content, "", "  ")
-			result.WriteString(string(jsonBytes))
+			result.Write(jsonBytes)
 		}
 	}
-	return ToolResult{ID: call.ID, Name: call.Function.Name, Content: result.String()}, nil
+	return result.String(), attachments
 }
 
-// BuildMCPServerURL builds the URL for an MCP server with full ValueSource resolution
-func BuildMCPServerURL(ctx context.Context, k8sClient client.Client, mcpServerCRD *arkv1alpha1.MCPServer) (string, error) {
-	address := mcpServerCRD.Spec.Address
-
-	// Handle direct value
-	if address.Value != "" {
-		return address.Value, nil
+// resultCache returns the query-scoped cache and key for this call, or a nil cache when caching does not apply
+func (m *MCPExecutor) resultCache(ctx context.Context, arguments map[string]any) (*ToolResultCache, string) {
+	if !m.CacheResults {
+		return nil, ""
 	}
-
-	// Handle service reference
-	if address.ValueFrom != nil && address.ValueFrom.ServiceRef != nil {
-		// Create a service reference with the MCP endpoint path
-		serviceRef := &arkv1alpha1.ServiceReference{
-			Name:      address.ValueFrom.ServiceRef.Name,
-			Namespace: address.ValueFrom.ServiceRef.Namespace,
-			Port:      address.ValueFrom.ServiceRef.Port,
-			Path:      address.ValueFrom.ServiceRef.Path, // Override path with MCP endpoint
-		}
-
-		return common.ResolveServiceReference(ctx, k8sClient, serviceRef, mcpServerCRD.Namespace)
+	cache := getToolResultCache(ctx)
+	if cache == nil {
+		return nil, ""
 	}
+	key, err := toolCacheKey(m.MCPClient.baseURL, m.ToolName, arguments)
+	if err != nil {
+		return nil, ""
+	}
+	return cache, key
+}
 
-	// Handle other ValueSource types (secrets, configmaps) using the ValueSourceResolver
+// BuildMCPServerURL builds the URL for an MCP server with full ValueSource resolution.
+// Service references go through common.ResolveServiceReference, the same path used for A2AServer addresses.
+func BuildMCPServerURL(ctx context.Context, k8sClient client.Client, mcpServerCRD *arkv1alpha1.MCPServer) (string, error) {
 	resolver := common.NewValueSourceResolver(k8sClient)
-	return resolver.ResolveValueSource(ctx, address, mcpServerCRD.Namespace)
+	return resolver.ResolveValueSource(ctx, mcpServerCRD.Spec.Address, mcpServerCRD.Namespace)
 }
 
 // ResolveHeaderValue resolves header values from secrets or configmaps (v1alpha1)
@@ -306,7 +417,7 @@ func ResolveHeaderValue(ctx context.Context, k8sClient client.Client, header ark
 	}
 
 	if header.Value.ValueFrom == nil {
-		return "", fmt.Errorf("header value must specify either value or valueFrom.secretKeyRef or valueFrom.configMapKeyRef")
+		return "", fmt.Errorf("header value must specify either value or valueFrom.secretKeyRef, valueFrom.configMapKeyRef or valueFrom.serviceAccountTokenRef")
 	}
 
 	if header.Value.ValueFrom.SecretKeyRef != nil {
@@ -317,7 +428,11 @@ func ResolveHeaderValue(ctx context.Context, k8sClient client.Client, header ark
 		return resolveHeaderFromConfigMap(ctx, k8sClient, header.Value.ValueFrom.ConfigMapKeyRef, namespace)
 	}
 
-	return "", fmt.Errorf("header value must specify either value or valueFrom.secretKeyRef or valueFrom.configMapKeyRef")
+	if header.Value.ValueFrom.ServiceAccountTokenRef != nil {
+		return resolveHeaderFromServiceAccountToken(ctx, k8sClient, header.Value.ValueFrom.ServiceAccountTokenRef, namespace)
+	}
+
+	return "", fmt.Errorf("header value must specify either value or valueFrom.secretKeyRef, valueFrom.configMapKeyRef or valueFrom.serviceAccountTokenRef")
 }
 
 func resolveHeaderFromSecret(ctx context.Context, k8sClient client.Client, secretRef *corev1.SecretKeySelector, namespace string) (string, error) {
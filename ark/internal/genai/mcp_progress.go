@@ -0,0 +1,53 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// mcpProgress collects the progress messages of in-flight MCP tool calls, keyed by progress token,
+// so that content received before a timeout can be returned as a partial result
+var mcpProgress = struct {
+	mu    sync.Mutex
+	next  atomic.Int64
+	calls map[string]*strings.Builder
+}{calls: map[string]*strings.Builder{}}
+
+func newMCPClientOptions() *mcp.ClientOptions {
+	return &mcp.ClientOptions{ProgressNotificationHandler: handleMCPProgress}
+}
+
+func handleMCPProgress(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+	if req.Params == nil || req.Params.Message == "" {
+		return
+	}
+	mcpProgress.mu.Lock()
+	defer mcpProgress.mu.Unlock()
+	if content, ok := mcpProgress.calls[fmt.Sprint(req.Params.ProgressToken)]; ok {
+		content.WriteString(req.Params.Message)
+	}
+}
+
+// trackMCPProgress registers a progress token for a tool call. The returned function stops tracking
+// and returns the progress messages received so far.
+func trackMCPProgress() (string, func() string) {
+	token := fmt.Sprintf("ark-%d", mcpProgress.next.Add(1))
+	mcpProgress.mu.Lock()
+	mcpProgress.calls[token] = &strings.Builder{}
+	mcpProgress.mu.Unlock()
+
+	return token, func() string {
+		mcpProgress.mu.Lock()
+		defer mcpProgress.mu.Unlock()
+		content := mcpProgress.calls[token].String()
+		delete(mcpProgress.calls, token)
+		return content
+	}
+}
@@ -0,0 +1,157 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+const (
+	// DefaultMCPMaxBackoff caps the wait before each MCP connection retry
+	DefaultMCPMaxBackoff = 30 * time.Second
+	// DefaultMCPMaxElapsedTime bounds the time spent connecting to an MCP server across all attempts
+	DefaultMCPMaxElapsedTime = 2 * time.Minute
+
+	defaultMCPConnectAttempts = 5
+)
+
+const mcpConnectRetryKey contextKey = "mcpConnectRetry"
+
+// mcpConnectLimits bounds the attempts of one MCP connection
+type mcpConnectLimits struct {
+	maxAttempts int
+	maxBackoff  time.Duration
+	maxElapsed  time.Duration
+}
+
+// WithMCPConnectRetry attaches an MCP server's connection retry settings to the context.
+// Settings left unset, or a nil retry, fall back to the defaults.
+func WithMCPConnectRetry(ctx context.Context, retry *arkv1alpha1.MCPConnectRetry) context.Context {
+	return context.WithValue(ctx, mcpConnectRetryKey, retry)
+}
+
+func mcpConnectLimitsFrom(ctx context.Context) mcpConnectLimits {
+	limits := mcpConnectLimits{
+		maxAttempts: defaultMCPConnectAttempts,
+		maxBackoff:  DefaultMCPMaxBackoff,
+		maxElapsed:  DefaultMCPMaxElapsedTime,
+	}
+	retry, _ := ctx.Value(mcpConnectRetryKey).(*arkv1alpha1.MCPConnectRetry)
+	if retry == nil {
+		return limits
+	}
+	if retry.MaxBackoff != nil && retry.MaxBackoff.Duration > 0 {
+		limits.maxBackoff = retry.MaxBackoff.Duration
+	}
+	if retry.MaxElapsedTime != nil && retry.MaxElapsedTime.Duration > 0 {
+		limits.maxElapsed = retry.MaxElapsedTime.Duration
+	}
+	return limits
+}
+
+// backoff returns the wait before the given attempt: the server's Retry-After hint when it sent one,
+// doubling from two seconds otherwise, and never more than the maximum backoff
+func (l mcpConnectLimits) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	backoff := time.Duration(1<<uint(attempt)) * time.Second
+	if retryAfter > 0 {
+		backoff = retryAfter
+	}
+	return min(backoff, l.maxBackoff)
+}
+
+// MCPStatusError is returned when an MCP server answers with an HTTP error status.
+// RetryAfter holds the server's Retry-After hint, or zero when it sent none.
+type MCPStatusError struct {
+	StatusCode int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *MCPStatusError) Error() string {
+	return fmt.Sprintf("MCP server returned status %d: %v", e.StatusCode, e.Err)
+}
+
+func (e *MCPStatusError) Unwrap() error {
+	return e.Err
+}
+
+func (e *MCPStatusError) ErrorType() string {
+	if e.Retryable() {
+		return telemetry.ErrorTypeServer
+	}
+	return telemetry.ErrorTypeInvalidRequest
+}
+
+// Retryable reports whether the status is a rate limit or a server error
+func (e *MCPStatusError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// mcpHTTPStatus keeps the last rate limit or server error status seen by a transport, because the
+// MCP SDK reports failed requests with the status text only. Other statuses, such as the 405 servers
+// send when they do not offer a standalone event stream, are not retried and are left out.
+type mcpHTTPStatus struct {
+	mu         sync.Mutex
+	statusCode int
+	retryAfter time.Duration
+}
+
+func (s *mcpHTTPStatus) record(resp *http.Response) {
+	if s == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statusCode = resp.StatusCode
+	s.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+}
+
+// wrap returns err as an MCPStatusError when the transport saw an error status, and err unchanged otherwise
+func (s *mcpHTTPStatus) wrap(err error) error {
+	if s == nil || err == nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.statusCode == 0 {
+		return err
+	}
+	return &MCPStatusError{StatusCode: s.statusCode, RetryAfter: s.retryAfter, Err: err}
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// mcpRetryAfter returns the Retry-After hint carried by err, if any
+func mcpRetryAfter(err error) time.Duration {
+	var statusErr *MCPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.RetryAfter
+	}
+	return 0
+}
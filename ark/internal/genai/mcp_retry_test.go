@@ -0,0 +1,151 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestIsRetryableErrorHTTPStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		retryable bool
+	}{
+		{http.StatusTooManyRequests, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+		{http.StatusNotFound, false},
+		{http.StatusUnauthorized, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("failed to connect: %w", &MCPStatusError{StatusCode: tt.status, Err: errors.New("broken session")})
+			assert.Equal(t, tt.retryable, isRetryableError(err))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("-1", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+	assert.Zero(t, parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+func TestMCPClientRetriesUnavailableServer(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "geocode"}, func(_ context.Context, _ *mcp.CallToolRequest, _ geocodeArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}}, nil, nil
+	})
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return server }, nil)
+
+	var rejected atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && rejected.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	client, err := createMCPClientWithRetry(context.Background(), srv.URL, nil, "http", 5*time.Second, mcpConnectLimits{maxAttempts: 3, maxBackoff: DefaultMCPMaxBackoff, maxElapsed: 30 * time.Second})
+	require.NoError(t, err)
+	defer func() { _ = client.client.Close() }()
+
+	// The Retry-After hint replaces the two second backoff of the first retry
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, time.Second)
+	assert.Less(t, elapsed, 2*time.Second)
+
+	tools, err := client.ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, tools, 1)
+}
+
+func TestMCPClientDoesNotRetryClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			requests.Add(1)
+		}
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := createMCPClientWithRetry(context.Background(), srv.URL, nil, "http", 5*time.Second, mcpConnectLimits{maxAttempts: 3, maxBackoff: DefaultMCPMaxBackoff, maxElapsed: 30 * time.Second})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestMCPClientStopsRetryingWhenCancelled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	// Without cancellation the first backoff alone takes two seconds
+	start := time.Now()
+	_, err := createMCPClientWithRetry(ctx, srv.URL, nil, "http", 5*time.Second, mcpConnectLimits{maxAttempts: 3, maxBackoff: DefaultMCPMaxBackoff, maxElapsed: 30 * time.Second})
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestMCPConnectLimits(t *testing.T) {
+	limits := mcpConnectLimitsFrom(context.Background())
+	assert.Equal(t, mcpConnectLimits{maxAttempts: 5, maxBackoff: DefaultMCPMaxBackoff, maxElapsed: DefaultMCPMaxElapsedTime}, limits)
+	assert.Equal(t, 2*time.Second, limits.backoff(1, 0))
+	assert.Equal(t, 16*time.Second, limits.backoff(4, 0))
+
+	ctx := WithMCPConnectRetry(context.Background(), &arkv1alpha1.MCPConnectRetry{MaxBackoff: &metav1.Duration{Duration: 5 * time.Second}})
+	limits = mcpConnectLimitsFrom(ctx)
+	assert.Equal(t, DefaultMCPMaxElapsedTime, limits.maxElapsed)
+	assert.Equal(t, 4*time.Second, limits.backoff(2, 0))
+	assert.Equal(t, 5*time.Second, limits.backoff(3, 0))
+	assert.Equal(t, 5*time.Second, limits.backoff(1, time.Minute), "Retry-After is capped too")
+}
+
+func TestMCPClientGivesUpAtMaxElapsedTime(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			requests.Add(1)
+		}
+		w.Header().Set("Retry-After", "2")
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	// The two second wait would end past the deadline, so the first failure is final
+	start := time.Now()
+	_, err := createMCPClientWithRetry(context.Background(), srv.URL, nil, "http", 5*time.Second, mcpConnectLimits{maxAttempts: 5, maxBackoff: DefaultMCPMaxBackoff, maxElapsed: time.Second})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gave up connecting")
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), requests.Load())
+}
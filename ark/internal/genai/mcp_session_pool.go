@@ -0,0 +1,194 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultMCPSessionIdleTimeout is how long a pooled MCP session with no users is kept open
+	DefaultMCPSessionIdleTimeout = 5 * time.Minute
+	// mcpSessionPingTimeout bounds the health check of a pooled session before it is handed out
+	mcpSessionPingTimeout = 5 * time.Second
+)
+
+var defaultMCPSessionPool = newMCPSessionPool(DefaultMCPSessionIdleTimeout, NewMCPClient)
+
+type mcpConnectFunc func(ctx context.Context, baseURL string, headers map[string]string, transportType string, timeout time.Duration, mcpSetting MCPSettings) (*MCPClient, error)
+
+// mcpSessionPool shares MCP sessions between tool registries, so agents calling the same server
+// with the same headers and settings reuse one session instead of each opening their own.
+// Sessions are reference counted and closed once they have been unused for the idle timeout.
+type mcpSessionPool struct {
+	idleTimeout time.Duration
+	connect     mcpConnectFunc
+
+	mu       sync.Mutex
+	sessions map[string]*pooledMCPSession
+	owners   map[*MCPClient]*pooledMCPSession
+}
+
+type pooledMCPSession struct {
+	key       string
+	client    *MCPClient
+	refs      int
+	detached  bool
+	idleTimer *time.Timer
+}
+
+func newMCPSessionPool(idleTimeout time.Duration, connect mcpConnectFunc) *mcpSessionPool {
+	return &mcpSessionPool{
+		idleTimeout: idleTimeout,
+		connect:     connect,
+		sessions:    make(map[string]*pooledMCPSession),
+		owners:      make(map[*MCPClient]*pooledMCPSession),
+	}
+}
+
+// acquire returns a healthy session for the server, reusing a pooled one when it still answers a ping.
+// Every acquired client must be given back with release.
+func (p *mcpSessionPool) acquire(ctx context.Context, baseURL string, headers map[string]string, transportType string, timeout time.Duration, mcpSetting MCPSettings) (*MCPClient, error) {
+	key := mcpSessionKey(baseURL, headers, transportType, timeout, mcpSetting)
+
+	p.mu.Lock()
+	pooled := p.sessions[key]
+	if pooled != nil {
+		pooled.refs++
+		if pooled.idleTimer != nil {
+			pooled.idleTimer.Stop()
+			pooled.idleTimer = nil
+		}
+	}
+	p.mu.Unlock()
+
+	if pooled != nil {
+		err := pingMCPSession(ctx, pooled.client)
+		if err == nil {
+			return pooled.client, nil
+		}
+		// Sessions already handed out keep the dead session; only new users get a fresh one
+		logf.FromContext(ctx).Info("pooled MCP session is unhealthy, reconnecting", "server", baseURL, "error", err)
+		p.detach(pooled)
+		_ = p.release(pooled.client)
+	}
+
+	mcpClient, err := p.connect(ctx, baseURL, headers, transportType, timeout, mcpSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if existing := p.sessions[key]; existing != nil {
+		// Another caller connected first; keep theirs in the pool and hand this one out unpooled
+		pooled = &pooledMCPSession{key: key, client: mcpClient, refs: 1, detached: true}
+	} else {
+		pooled = &pooledMCPSession{key: key, client: mcpClient, refs: 1}
+		p.sessions[key] = pooled
+	}
+	p.owners[mcpClient] = pooled
+	return mcpClient, nil
+}
+
+// release gives back a client from acquire. The session is closed after the idle timeout once no one
+// uses it, or straight away if it was replaced in the pool.
+func (p *mcpSessionPool) release(mcpClient *MCPClient) error {
+	p.mu.Lock()
+	pooled := p.owners[mcpClient]
+	if pooled == nil || pooled.refs == 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	pooled.refs--
+	if pooled.refs > 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	if pooled.detached || p.idleTimeout <= 0 {
+		p.remove(pooled)
+		p.mu.Unlock()
+		return closeMCPClient(mcpClient)
+	}
+	pooled.idleTimer = time.AfterFunc(p.idleTimeout, func() { p.evict(pooled) })
+	p.mu.Unlock()
+	return nil
+}
+
+// evict closes a session that stayed unused for the idle timeout
+func (p *mcpSessionPool) evict(pooled *pooledMCPSession) {
+	p.mu.Lock()
+	if pooled.refs > 0 || pooled.idleTimer == nil {
+		p.mu.Unlock()
+		return
+	}
+	pooled.idleTimer = nil
+	p.remove(pooled)
+	p.mu.Unlock()
+	_ = closeMCPClient(pooled.client)
+}
+
+// detach takes a session out of the pool so it is closed when its last user releases it
+func (p *mcpSessionPool) detach(pooled *pooledMCPSession) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pooled.detached = true
+	if p.sessions[pooled.key] == pooled {
+		delete(p.sessions, pooled.key)
+	}
+}
+
+func (p *mcpSessionPool) remove(pooled *pooledMCPSession) {
+	delete(p.owners, pooled.client)
+	if p.sessions[pooled.key] == pooled {
+		delete(p.sessions, pooled.key)
+	}
+}
+
+func pingMCPSession(ctx context.Context, mcpClient *MCPClient) error {
+	if mcpClient.client == nil {
+		return fmt.Errorf("MCP session is not connected")
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, mcpSessionPingTimeout)
+	defer cancel()
+	return mcpClient.client.Ping(pingCtx, nil)
+}
+
+func closeMCPClient(mcpClient *MCPClient) error {
+	if mcpClient.client == nil {
+		return nil
+	}
+	if err := mcpClient.client.Close(); err != nil {
+		return fmt.Errorf("failed to close MCP session for %s: %w", mcpClient.baseURL, err)
+	}
+	return nil
+}
+
+// mcpSessionKey identifies sessions that can be shared. Headers and settings are hashed so that
+// credentials are not kept as map keys, and settings are part of the key because their tool calls
+// set up state on the session.
+func mcpSessionKey(baseURL string, headers map[string]string, transportType string, timeout time.Duration, mcpSetting MCPSettings) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00", baseURL, transportType, timeout)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00", name, headers[name])
+	}
+	settings, _ := json.Marshal(mcpSetting)
+	_, _ = h.Write(settings)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,93 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMCPSessionPool(t *testing.T, idleTimeout time.Duration) (*mcpSessionPool, *int) {
+	t.Helper()
+	connects := 0
+	pool := newMCPSessionPool(idleTimeout, func(_ context.Context, _ string, _ map[string]string, _ string, _ time.Duration, _ MCPSettings) (*MCPClient, error) {
+		connects++
+		return connectInMemoryMCPServer(t, mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)), nil
+	})
+	return pool, &connects
+}
+
+func TestMCPSessionPoolReusesSessions(t *testing.T) {
+	pool, connects := newTestMCPSessionPool(t, time.Hour)
+	ctx := context.Background()
+	headers := map[string]string{"Authorization": "Bearer a"}
+
+	first, err := pool.acquire(ctx, "http://geo", headers, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	second, err := pool.acquire(ctx, "http://geo", headers, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, *connects)
+
+	other, err := pool.acquire(ctx, "http://geo", map[string]string{"Authorization": "Bearer b"}, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	assert.NotSame(t, first, other, "different headers get their own session")
+	assert.Equal(t, 2, *connects)
+
+	require.NoError(t, pool.release(first))
+	require.NoError(t, pool.release(second))
+	require.NoError(t, first.client.Ping(ctx, nil), "idle sessions stay open until the idle timeout")
+
+	again, err := pool.acquire(ctx, "http://geo", headers, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	assert.Same(t, first, again)
+	assert.Equal(t, 2, *connects)
+}
+
+func TestMCPSessionPoolEvictsIdleSessions(t *testing.T) {
+	pool, connects := newTestMCPSessionPool(t, 10*time.Millisecond)
+	ctx := context.Background()
+
+	first, err := pool.acquire(ctx, "http://geo", nil, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	require.NoError(t, pool.release(first))
+
+	require.Eventually(t, func() bool {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		return len(pool.sessions) == 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Error(t, first.client.Ping(ctx, nil))
+
+	second, err := pool.acquire(ctx, "http://geo", nil, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, 2, *connects)
+}
+
+func TestMCPSessionPoolReplacesDeadSessions(t *testing.T) {
+	pool, connects := newTestMCPSessionPool(t, time.Hour)
+	ctx := context.Background()
+
+	first, err := pool.acquire(ctx, "http://geo", nil, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	require.NoError(t, first.client.Close())
+
+	second, err := pool.acquire(ctx, "http://geo", nil, "http", time.Second, MCPSettings{})
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, 2, *connects)
+	require.NoError(t, second.client.Ping(ctx, nil))
+
+	// The dead session is dropped once its last user gives it back
+	_ = pool.release(first)
+	pool.mu.Lock()
+	_, owned := pool.owners[first]
+	pool.mu.Unlock()
+	assert.False(t, owned)
+}
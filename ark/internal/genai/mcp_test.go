@@ -0,0 +1,117 @@
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestMCPExecutorErrorResults(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "geocode"}, func(_ context.Context, _ *mcp.CallToolRequest, args geocodeArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{&mcp.TextContent{Text: "unknown city " + args.City}},
+		}, nil, nil
+	})
+
+	executor := &MCPExecutor{MCPClient: connectInMemoryMCPServer(t, server), ToolName: "geocode"}
+	result, err := executor.Execute(context.Background(), geocodeCall(`{"city":"Atlantis"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "unknown city Atlantis", result.Error)
+	assert.Equal(t, "unknown city Atlantis", result.MessageContent())
+
+	disconnected := &MCPExecutor{MCPClient: &MCPClient{baseURL: "memory://geo"}, ToolName: "geocode"}
+	result, err = disconnected.Execute(context.Background(), geocodeCall(`{}`), nil)
+	require.Error(t, err)
+	assert.Equal(t, "call-1", result.ID)
+	assert.Equal(t, err.Error(), result.Error)
+	assert.Equal(t, "Error: "+err.Error(), result.MessageContent())
+}
+
+func TestMCPExecutorPartialResultOnTimeout(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "geocode"}, func(ctx context.Context, req *mcp.CallToolRequest, _ geocodeArgs) (*mcp.CallToolResult, any, error) {
+		for i, chunk := range []string{"Paris: 48.85N", ", 2.35E"} {
+			_ = req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+				ProgressToken: req.Params.GetProgressToken(),
+				Progress:      float64(i + 1),
+				Message:       chunk,
+			})
+		}
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	})
+
+	executor := &MCPExecutor{MCPClient: connectInMemoryMCPServer(t, server), ToolName: "geocode"}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	result, err := executor.Execute(ctx, geocodeCall(`{"city":"Paris"}`), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, result.Partial)
+	assert.Equal(t, "Paris: 48.85N, 2.35E", result.Content)
+	assert.Contains(t, result.MessageContent(), "[Partial result, the tool call did not complete")
+}
+
+func TestMCPExecutorNonTextContent(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "geocode"}, func(_ context.Context, _ *mcp.CallToolRequest, _ geocodeArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Map of Paris: "},
+				&mcp.ImageContent{MIMEType: "image/png", Data: []byte("png")},
+				&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "geo://paris", MIMEType: "text/plain", Text: " 48.85N, 2.35E"}},
+			},
+		}, nil, nil
+	})
+
+	executor := &MCPExecutor{MCPClient: connectInMemoryMCPServer(t, server), ToolName: "geocode"}
+	result, err := executor.Execute(context.Background(), geocodeCall(`{"city":"Paris"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Map of Paris: [image: image/png, 3 bytes] 48.85N, 2.35E", result.Content)
+	assert.Equal(t, []ToolResultAttachment{
+		{Type: "image", MimeType: "image/png", Data: "cG5n"},
+		{Type: "resource", MimeType: "text/plain", URI: "geo://paris"},
+	}, result.Attachments)
+}
+
+func TestMCPToolFilter(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	for _, name := range []string{"geocode", "get_weather", "get_forecast", "delete_city"} {
+		mcp.AddTool(server, &mcp.Tool{Name: name}, func(_ context.Context, _ *mcp.CallToolRequest, _ geocodeArgs) (*mcp.CallToolResult, any, error) {
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}}, nil, nil
+		})
+	}
+	client := connectInMemoryMCPServer(t, server)
+	filter := &arkv1alpha1.MCPToolFilter{Allow: []string{"get_*", "delete_city"}, Deny: []string{"delete_*"}}
+
+	tools, err := client.ListTools(context.Background(), filter)
+	require.NoError(t, err)
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	assert.ElementsMatch(t, []string{"get_weather", "get_forecast"}, names)
+
+	all, err := client.ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 4)
+
+	denied := &MCPExecutor{MCPClient: client, ToolName: "delete_city", ToolFilter: filter}
+	result, err := denied.Execute(context.Background(), geocodeCall(`{}`), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not allowed by the tool filter")
+	assert.Equal(t, err.Error(), result.Error)
+
+	allowed := &MCPExecutor{MCPClient: client, ToolName: "get_weather", ToolFilter: filter}
+	result, err = allowed.Execute(context.Background(), geocodeCall(`{"city":"Paris"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", result.Content)
+}
@@ -41,6 +41,24 @@ type MemoryInterface interface {
 	Close() error
 }
 
+// ScopedMemory is implemented by memory backends that can partition a session into independent scopes
+type ScopedMemory interface {
+	WithScope(scope string) MemoryInterface
+}
+
+// TeamMemoryScope returns the memory scope used for a team's conversation
+func TeamMemoryScope(teamName string) string {
+	return "team/" + teamName
+}
+
+// ScopeMemory returns the memory restricted to scope, or the memory unchanged if the backend does not support scopes
+func ScopeMemory(memory MemoryInterface, scope string) MemoryInterface {
+	if scoped, ok := memory.(ScopedMemory); ok && scope != "" {
+		return scoped.WithScope(scope)
+	}
+	return memory
+}
+
 type Config struct {
 	Timeout    time.Duration
 	MaxRetries int
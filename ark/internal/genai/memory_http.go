@@ -64,6 +64,14 @@ func NewHTTPMemory(ctx context.Context, k8sClient client.Client, memoryName, nam
 	}, nil
 }
 
+// WithScope returns a memory that stores and retrieves messages under a session scoped to the given key.
+// The HTTP client is shared with the parent memory.
+func (m *HTTPMemory) WithScope(scope string) MemoryInterface {
+	scoped := *m
+	scoped.sessionId = fmt.Sprintf("%s/%s", m.sessionId, scope)
+	return &scoped
+}
+
 // resolveAndUpdateAddress dynamically resolves the memory address and updates the status if it changed
 func (m *HTTPMemory) resolveAndUpdateAddress(ctx context.Context) error {
 	memory, err := getMemoryResource(ctx, m.client, m.name, m.namespace)
@@ -247,7 +255,8 @@ func unmarshalMessageRobust(rawJSON json.RawMessage) (openai.ChatCompletionMessa
 
 	// Step 4: Convert simple format to proper OpenAI message based on known roles
 	// For unknown roles, try user message as fallback (most permissive)
-	switch simple.Role {
+	role, _ := NormalizeRole(simple.Role)
+	switch role {
 	case RoleUser:
 		return openai.UserMessage(simple.Content), nil
 	case RoleAssistant:
@@ -1,10 +1,21 @@
 package genai
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 )
 
 func TestUnmarshalMessageRobust(t *testing.T) {
@@ -145,3 +156,68 @@ func TestUnmarshalMessageRobustFutureRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPMemoryTeamScopes(t *testing.T) {
+	var mu sync.Mutex
+	sessions := map[string][]json.RawMessage{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPost:
+			var req struct {
+				SessionID string            `json:"session_id"`
+				Messages  []json.RawMessage `json:"messages"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			sessions[req.SessionID] = append(sessions[req.SessionID], req.Messages...)
+		case http.MethodGet:
+			var records []MessageRecord
+			for _, msg := range sessions[r.URL.Query().Get("session_id")] {
+				records = append(records, MessageRecord{Message: msg})
+			}
+			_ = json.NewEncoder(w).Encode(MessagesResponse{Messages: records, Total: len(records)})
+		}
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, arkv1alpha1.AddToScheme(scheme))
+	address := server.URL
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&arkv1alpha1.Memory{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+		Spec:       arkv1alpha1.MemorySpec{Address: arkv1alpha1.ValueSource{Value: address}},
+		Status:     arkv1alpha1.MemoryStatus{LastResolvedAddress: &address},
+	}).Build()
+
+	ctx := context.Background()
+	config := DefaultConfig()
+	config.SessionId = "session-1"
+	memory, err := NewHTTPMemory(ctx, k8sClient, "default", "default", &mockRecorder{}, config)
+	require.NoError(t, err)
+
+	researchMemory := ScopeMemory(memory, TeamMemoryScope("research"))
+	writingMemory := ScopeMemory(memory, TeamMemoryScope("writing"))
+
+	require.NoError(t, researchMemory.AddMessages(ctx, "query", []Message{NewUserMessage("research question")}))
+	require.NoError(t, writingMemory.AddMessages(ctx, "query", []Message{NewUserMessage("writing question")}))
+
+	researchMessages, err := researchMemory.GetMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, researchMessages, 1)
+	assert.Equal(t, "research question", researchMessages[0].OfUser.Content.OfString.Value)
+
+	writingMessages, err := writingMemory.GetMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, writingMessages, 1)
+	assert.Equal(t, "writing question", writingMessages[0].OfUser.Content.OfString.Value)
+
+	sessionMessages, err := memory.GetMessages(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, sessionMessages)
+}
+
+func TestScopeMemoryWithoutScopeSupport(t *testing.T) {
+	memory := NewNoopMemory()
+	assert.Same(t, memory, ScopeMemory(memory, TeamMemoryScope("research")))
+}
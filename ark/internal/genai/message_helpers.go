@@ -2,7 +2,13 @@
 
 package genai
 
-import "github.com/openai/openai-go"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openai/openai-go"
+	"trpc.group/trpc-go/trpc-a2a-go/protocol"
+)
 
 // PrepareExecutionMessages separates the current message from context messages
 // and combines with memory history for agent/team execution.
@@ -51,3 +57,53 @@ func PrepareNewMessagesForMemory(inputMessages, responseMessages []Message) []Me
 	newMessages = append(newMessages, responseMessages...)
 	return newMessages
 }
+
+// PruneMessagesForMemory drops intermediate tool traffic before memory storage.
+// Tool result messages are removed and the tool calls of assistant messages are cleared, so the stored
+// history never holds a tool call without its result. Assistant messages left without content are removed,
+// keeping user turns, system messages and final assistant answers.
+func PruneMessagesForMemory(messages []Message) []Message {
+	pruned := make([]Message, 0, len(messages))
+	for _, msg := range messages {
+		switch {
+		case msg.OfTool != nil:
+			continue
+		case msg.OfAssistant != nil && len(msg.OfAssistant.ToolCalls) > 0:
+			if !hasAssistantContent(msg.OfAssistant) {
+				continue
+			}
+			assistant := *msg.OfAssistant
+			assistant.ToolCalls = nil
+			msg = Message{OfAssistant: &assistant}
+		}
+		pruned = append(pruned, msg)
+	}
+	return pruned
+}
+
+// hasAssistantContent reports whether an assistant message carries text or a refusal
+func hasAssistantContent(msg *openai.ChatCompletionAssistantMessageParam) bool {
+	if msg.Content.OfString.Value != "" || msg.Refusal.Value != "" {
+		return true
+	}
+	for _, part := range msg.Content.OfArrayOfContentParts {
+		if (part.OfText != nil && part.OfText.Text != "") || (part.OfRefusal != nil && part.OfRefusal.Refusal != "") {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeRole maps a role name from any message source to the canonical genai role.
+// Casing and surrounding whitespace are ignored and the A2A "agent" role becomes "assistant".
+// Roles outside the allowed set return an error so callers can decide on a fallback.
+func NormalizeRole(role string) (string, error) {
+	switch normalized := strings.ToLower(strings.TrimSpace(role)); normalized {
+	case RoleUser, RoleAssistant, RoleSystem, RoleTool:
+		return normalized, nil
+	case string(protocol.MessageRoleAgent):
+		return RoleAssistant, nil
+	default:
+		return "", fmt.Errorf("unsupported message role %q", role)
+	}
+}
@@ -346,6 +346,130 @@ func TestPrepareNewMessagesForMemory(t *testing.T) {
 	}
 }
 
+func TestPruneMessagesForMemory(t *testing.T) {
+	toolCallMessage := Message(openai.ChatCompletionMessage{
+		Role: "assistant",
+		ToolCalls: []openai.ChatCompletionMessageToolCall{
+			{ID: "call-1", Type: "function", Function: openai.ChatCompletionMessageToolCallFunction{Name: "get_weather", Arguments: "{}"}},
+		},
+	}.ToParam())
+
+	messages := []Message{
+		createTestMessage("system", testContentSystemPrompt),
+		createTestMessage("user", testContentSingleQuestion),
+		toolCallMessage,
+		ToolMessage("sunny", "call-1"),
+		createTestMessage("assistant", testContentSingleAnswer),
+	}
+
+	want := []Message{
+		createTestMessage("system", testContentSystemPrompt),
+		createTestMessage("user", testContentSingleQuestion),
+		createTestMessage("assistant", testContentSingleAnswer),
+	}
+
+	got := PruneMessagesForMemory(messages)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PruneMessagesForMemory() = %v, want %v", got, want)
+	}
+
+	if got := PruneMessagesForMemory([]Message{}); len(got) != 0 {
+		t.Errorf("PruneMessagesForMemory() on empty input = %v, want empty", got)
+	}
+}
+
+func TestPruneMessagesForMemoryClearsToolCalls(t *testing.T) {
+	toolCalls := []openai.ChatCompletionMessageToolCallParam{
+		{ID: "call-1", Function: openai.ChatCompletionMessageToolCallFunctionParam{Name: "get_weather", Arguments: "{}"}},
+	}
+	withText := Message{OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+		Content:   openai.ChatCompletionAssistantMessageParamContentUnion{OfString: openai.String("Let me check the weather")},
+		ToolCalls: toolCalls,
+	}}
+	withParts := Message{OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+		Content: openai.ChatCompletionAssistantMessageParamContentUnion{
+			OfArrayOfContentParts: []openai.ChatCompletionAssistantMessageParamContentArrayOfContentPartUnion{
+				{OfText: &openai.ChatCompletionContentPartTextParam{Text: "Checking again"}},
+			},
+		},
+		ToolCalls: toolCalls,
+	}}
+	emptyParts := Message{OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+		Content: openai.ChatCompletionAssistantMessageParamContentUnion{
+			OfArrayOfContentParts: []openai.ChatCompletionAssistantMessageParamContentArrayOfContentPartUnion{
+				{OfText: &openai.ChatCompletionContentPartTextParam{}},
+			},
+		},
+		ToolCalls: toolCalls,
+	}}
+
+	messages := []Message{
+		createTestMessage("user", testContentSingleQuestion),
+		withText,
+		ToolMessage("sunny", "call-1"),
+		withParts,
+		ToolMessage("sunny", "call-1"),
+		emptyParts,
+		ToolMessage("sunny", "call-1"),
+		createTestMessage("assistant", testContentSingleAnswer),
+	}
+
+	got := PruneMessagesForMemory(messages)
+	if len(got) != 4 {
+		t.Fatalf("PruneMessagesForMemory() returned %d messages, want 4: %v", len(got), got)
+	}
+	for i, msg := range got[1:3] {
+		if msg.OfAssistant == nil || len(msg.OfAssistant.ToolCalls) != 0 {
+			t.Errorf("message %d: want an assistant message without tool calls, got %v", i+1, msg)
+		}
+	}
+	if got[1].OfAssistant.Content.OfString.Value != "Let me check the weather" {
+		t.Errorf("message 1 content = %q, want the original text", got[1].OfAssistant.Content.OfString.Value)
+	}
+	if len(got[2].OfAssistant.Content.OfArrayOfContentParts) != 1 {
+		t.Errorf("message 2 content parts = %v, want the original parts", got[2].OfAssistant.Content.OfArrayOfContentParts)
+	}
+	if len(withText.OfAssistant.ToolCalls) == 0 {
+		t.Error("PruneMessagesForMemory() modified its input")
+	}
+}
+
+func TestNormalizeRole(t *testing.T) {
+	tests := []struct {
+		role    string
+		want    string
+		wantErr bool
+	}{
+		{role: "user", want: RoleUser},
+		{role: "assistant", want: RoleAssistant},
+		{role: "agent", want: RoleAssistant},
+		{role: " Agent ", want: RoleAssistant},
+		{role: "SYSTEM", want: RoleSystem},
+		{role: "tool", want: RoleTool},
+		{role: "moderator", wantErr: true},
+		{role: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			got, err := NormalizeRole(tt.role)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeRole(%q) error = %v, wantErr %v", tt.role, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeRole(%q) = %q, want %q", tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertFromExecutionEngineMessageAgentRole(t *testing.T) {
+	got := convertFromExecutionEngineMessage(ExecutionEngineMessage{Role: "agent", Content: testContentSingleAnswer})
+	if !reflect.DeepEqual(got, createTestMessage("assistant", testContentSingleAnswer)) {
+		t.Errorf("convertFromExecutionEngineMessage() = %v, want assistant message", got)
+	}
+}
+
 // Benchmark tests to ensure efficient memory allocation
 func BenchmarkPrepareExecutionMessages(b *testing.B) {
 	inputMessages := make([]Message, 5)
@@ -65,6 +65,14 @@ func (t *OperationTracker) CompleteWithTokens(tokenUsage TokenUsage) {
 	t.emitCompletion(corev1.EventTypeNormal, t.operation+"Complete", "", tokenUsage)
 }
 
+func (t *OperationTracker) CompleteWithTokensAndMetadata(result string, tokenUsage TokenUsage, additionalMetadata map[string]string) {
+	log := logf.FromContext(t.ctx)
+	if log.V(3).Enabled() && result != "" {
+		log.V(3).Info("operation response with metadata", "operation", t.operation, "name", t.name, "response", result, "metadata", additionalMetadata)
+	}
+	t.emitCompletionWithMetadata(corev1.EventTypeNormal, t.operation+"Complete", "", tokenUsage, additionalMetadata)
+}
+
 func (t *OperationTracker) Fail(err error) {
 	errorMsg := ""
 	if err != nil {
@@ -0,0 +1,65 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+const queryResourcesKey contextKey = "queryResources"
+
+// QueryResources holds resources opened while executing one query, such as the MCP sessions of
+// agent tool registries, so they are released together when the query finishes
+type QueryResources struct {
+	mu      sync.Mutex
+	closers []io.Closer
+	closed  bool
+}
+
+// WithQueryResources attaches a new query-scoped resource registry to the context.
+// The caller must call Close on the returned registry once the query has finished.
+func WithQueryResources(ctx context.Context) (context.Context, *QueryResources) {
+	resources := &QueryResources{}
+	return context.WithValue(ctx, queryResourcesKey, resources), resources
+}
+
+// RegisterCloser adds a resource to the query's registry and reports whether it was registered.
+// Without a registry in the context the caller stays responsible for closing the resource.
+// A resource registered after the registry was closed is closed straight away.
+func RegisterCloser(ctx context.Context, closer io.Closer) bool {
+	resources, _ := ctx.Value(queryResourcesKey).(*QueryResources)
+	if resources == nil {
+		return false
+	}
+
+	resources.mu.Lock()
+	if resources.closed {
+		resources.mu.Unlock()
+		_ = closer.Close()
+		return true
+	}
+	resources.closers = append(resources.closers, closer)
+	resources.mu.Unlock()
+	return true
+}
+
+// Close closes the registered resources in reverse order of registration and returns their errors joined.
+// Closing more than once has no further effect.
+func (r *QueryResources) Close() error {
+	r.mu.Lock()
+	closers := r.closers
+	r.closers = nil
+	r.closed = true
+	r.mu.Unlock()
+
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i].Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,47 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingCloser struct {
+	name   string
+	err    error
+	closed *[]string
+}
+
+func (c *recordingCloser) Close() error {
+	*c.closed = append(*c.closed, c.name)
+	return c.err
+}
+
+func TestQueryResourcesClose(t *testing.T) {
+	var closed []string
+	ctx, resources := WithQueryResources(context.Background())
+
+	failure := errors.New("session already gone")
+	assert.True(t, RegisterCloser(ctx, &recordingCloser{name: "mcp", closed: &closed}))
+	assert.True(t, RegisterCloser(ctx, &recordingCloser{name: "tools", err: failure, closed: &closed}))
+
+	require.ErrorIs(t, resources.Close(), failure)
+	assert.Equal(t, []string{"tools", "mcp"}, closed)
+
+	require.NoError(t, resources.Close())
+	assert.Len(t, closed, 2, "closing again does not close resources twice")
+
+	assert.True(t, RegisterCloser(ctx, &recordingCloser{name: "late", closed: &closed}))
+	assert.Equal(t, []string{"tools", "mcp", "late"}, closed)
+}
+
+func TestRegisterCloserWithoutRegistry(t *testing.T) {
+	var closed []string
+	assert.False(t, RegisterCloser(context.Background(), &recordingCloser{name: "mcp", closed: &closed}))
+	assert.Empty(t, closed)
+}
@@ -60,6 +60,19 @@ func (r *Recorder[T]) EmitEvent(ctx context.Context, eventType, reason string, d
 	}
 }
 
+// EventRecorder returns the Kubernetes event recorder behind the recorder, for events on other objects
+func (r *Recorder[T]) EventRecorder() record.EventRecorder {
+	return r.recorder
+}
+
+// kubernetesEventRecorder returns the Kubernetes event recorder behind an emitter, or nil when it has none
+func kubernetesEventRecorder(emitter EventEmitter) record.EventRecorder {
+	if provider, ok := emitter.(interface{ EventRecorder() record.EventRecorder }); ok {
+		return provider.EventRecorder()
+	}
+	return nil
+}
+
 func (r *Recorder[T]) isResourceNil() bool {
 	var zero T
 	return any(r.resource) == any(zero)
@@ -0,0 +1,57 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+)
+
+const retryBudgetKey contextKey = "retryBudget"
+
+// DefaultRetryBudget is the number of retries shared by all retry loops of one query or reconcile
+const DefaultRetryBudget = 10
+
+// RetryBudget bounds the total number of retries across layers, such as MCP connection retries
+// and A2A address failover, so stacked retry loops cannot amplify load during an outage
+type RetryBudget struct {
+	mu          sync.Mutex
+	remaining   int
+	exhausted   bool
+	onExhausted func(operation string)
+}
+
+// WithRetryBudget attaches a retry budget to the context. onExhausted, if set, is called once
+// with the operation that was denied the first retry over budget.
+func WithRetryBudget(ctx context.Context, retries int, onExhausted func(operation string)) context.Context {
+	return context.WithValue(ctx, retryBudgetKey, &RetryBudget{remaining: retries, onExhausted: onExhausted})
+}
+
+func getRetryBudget(ctx context.Context) *RetryBudget {
+	budget, _ := ctx.Value(retryBudgetKey).(*RetryBudget)
+	return budget
+}
+
+// AllowRetry consumes one retry from the context's budget and reports whether the retry may proceed.
+// Contexts without a budget always allow retries.
+func AllowRetry(ctx context.Context, operation string) bool {
+	budget := getRetryBudget(ctx)
+	if budget == nil {
+		return true
+	}
+
+	budget.mu.Lock()
+	if budget.remaining > 0 {
+		budget.remaining--
+		budget.mu.Unlock()
+		return true
+	}
+	notify := !budget.exhausted && budget.onExhausted != nil
+	budget.exhausted = true
+	budget.mu.Unlock()
+
+	if notify {
+		budget.onExhausted(operation)
+	}
+	return false
+}
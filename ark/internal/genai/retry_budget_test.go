@@ -0,0 +1,53 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowRetry(t *testing.T) {
+	assert.True(t, AllowRetry(context.Background(), "unbounded"))
+
+	var exhausted []string
+	ctx := WithRetryBudget(context.Background(), 2, func(operation string) {
+		exhausted = append(exhausted, operation)
+	})
+
+	assert.True(t, AllowRetry(ctx, "first"))
+	assert.True(t, AllowRetry(ctx, "second"))
+	assert.False(t, AllowRetry(ctx, "third"))
+	assert.False(t, AllowRetry(ctx, "fourth"))
+	assert.Equal(t, []string{"third"}, exhausted)
+}
+
+func TestA2AFailoverHonorsRetryBudget(t *testing.T) {
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	requests := 0
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"name":"weather-agent","url":"http://example"}`))
+	}))
+	defer healthy.Close()
+
+	var exhausted []string
+	ctx := WithRetryBudget(context.Background(), 0, func(operation string) {
+		exhausted = append(exhausted, operation)
+	})
+
+	_, _, err := DiscoverA2AAgentsWithFailover(ctx, nil, []string{unreachableURL, healthy.URL}, nil, "default", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "retry budget exhausted")
+	assert.Zero(t, requests)
+	// The discovery retry of the unreachable address is the first to be denied
+	assert.Equal(t, []string{"A2A discovery from " + unreachableURL + AgentCardPathVersion3}, exhausted)
+}
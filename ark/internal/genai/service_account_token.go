@@ -0,0 +1,70 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultServiceAccountTokenExpirationSeconds int64 = 3600
+
+type cachedServiceAccountToken struct {
+	token     string
+	refreshAt time.Time
+}
+
+// serviceAccountTokens caches minted tokens so each header resolution does not issue a TokenRequest
+var serviceAccountTokens = struct {
+	mu     sync.Mutex
+	tokens map[string]cachedServiceAccountToken
+}{tokens: map[string]cachedServiceAccountToken{}}
+
+// resolveHeaderFromServiceAccountToken returns a projected token for the service account, reusing a cached
+// token until 80% of its lifetime has passed
+func resolveHeaderFromServiceAccountToken(ctx context.Context, k8sClient client.Client, tokenRef *arkv1alpha1.ServiceAccountTokenSelector, namespace string) (string, error) {
+	expirationSeconds := defaultServiceAccountTokenExpirationSeconds
+	if tokenRef.ExpirationSeconds != nil {
+		expirationSeconds = *tokenRef.ExpirationSeconds
+	}
+	key := fmt.Sprintf("%s/%s/%s/%d", namespace, tokenRef.Name, tokenRef.Audience, expirationSeconds)
+
+	serviceAccountTokens.mu.Lock()
+	defer serviceAccountTokens.mu.Unlock()
+
+	now := time.Now()
+	if cached, ok := serviceAccountTokens.tokens[key]; ok && now.Before(cached.refreshAt) {
+		return tokenRef.Prefix + cached.token, nil
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	if tokenRef.Audience != "" {
+		tokenRequest.Spec.Audiences = []string{tokenRef.Audience}
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: tokenRef.Name, Namespace: namespace},
+	}
+	if err := k8sClient.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return "", fmt.Errorf("failed to create token for service account %s/%s: %w", namespace, tokenRef.Name, err)
+	}
+
+	lifetime := tokenRequest.Status.ExpirationTimestamp.Sub(now)
+	serviceAccountTokens.tokens[key] = cachedServiceAccountToken{
+		token:     tokenRequest.Status.Token,
+		refreshAt: now.Add(lifetime * 4 / 5),
+	}
+	return tokenRef.Prefix + tokenRequest.Status.Token, nil
+}
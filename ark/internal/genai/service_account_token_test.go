@@ -0,0 +1,74 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestResolveHeaderFromServiceAccountToken(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	var requests []authenticationv1.TokenRequestSpec
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "mcp-caller", Namespace: "default"}}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				tokenRequest := subResource.(*authenticationv1.TokenRequest)
+				requests = append(requests, tokenRequest.Spec)
+				tokenRequest.Status.Token = "minted-token"
+				tokenRequest.Status.ExpirationTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+				return nil
+			},
+		}).
+		Build()
+
+	serviceAccountTokens.tokens = map[string]cachedServiceAccountToken{}
+	defer func() { serviceAccountTokens.tokens = map[string]cachedServiceAccountToken{} }()
+
+	header := arkv1alpha1.Header{
+		Name: "Authorization",
+		Value: arkv1alpha1.HeaderValue{
+			ValueFrom: &arkv1alpha1.HeaderValueSource{
+				ServiceAccountTokenRef: &arkv1alpha1.ServiceAccountTokenSelector{
+					Name:     "mcp-caller",
+					Audience: "mcp-server",
+					Prefix:   "Bearer ",
+				},
+			},
+		},
+	}
+
+	value, err := ResolveHeaderValue(context.Background(), k8sClient, header, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer minted-token", value)
+
+	_, err = ResolveHeaderValue(context.Background(), k8sClient, header, "default")
+	require.NoError(t, err)
+	require.Len(t, requests, 1, "a cached token is reused until it nears expiry")
+	assert.Equal(t, []string{"mcp-server"}, requests[0].Audiences)
+	assert.Equal(t, defaultServiceAccountTokenExpirationSeconds, *requests[0].ExpirationSeconds)
+
+	for key, cached := range serviceAccountTokens.tokens {
+		cached.refreshAt = time.Now().Add(-time.Second)
+		serviceAccountTokens.tokens[key] = cached
+	}
+	_, err = ResolveHeaderValue(context.Background(), k8sClient, header, "default")
+	require.NoError(t, err)
+	assert.Len(t, requests, 2)
+}
@@ -2,29 +2,49 @@ package genai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/telemetry"
 )
 
 type Team struct {
-	Name        string
-	Members     []TeamMember
-	Strategy    string
-	Description string
-	MaxTurns    *int
-	Selector    *arkv1alpha1.TeamSelectorSpec
-	Graph       *arkv1alpha1.TeamGraphSpec
-	Recorder    EventEmitter
-	Client      client.Client
-	Namespace   string
-	memory      MemoryInterface
-	eventStream EventStreamInterface
+	Name         string
+	Members      []TeamMember
+	Strategy     string
+	Description  string
+	MaxTurns     *int
+	Timeout      time.Duration
+	Retries      int
+	RetryBackoff time.Duration
+	Selector     *arkv1alpha1.TeamSelectorSpec
+	Graph        *arkv1alpha1.TeamGraphSpec
+	Router       *arkv1alpha1.TeamRouterSpec
+	Recorder     EventEmitter
+	Client       client.Client
+	Namespace    string
+	memory       MemoryInterface
+	eventStream  EventStreamInterface
+	graphPath    []string
+	executed     int
+	results      chan<- MemberResult
+	heldResults  *memberResultBuffer
+	run          *teamRunRecorder
+}
+
+// MemberResult is the outcome of a single member turn, delivered by ExecuteStream as soon as the member finishes
+type MemberResult struct {
+	Member   string
+	Turn     int
+	Messages []Message
+	Err      error
 }
 
 // FullName returns the namespace/name format for the team
@@ -40,6 +60,7 @@ func (t *Team) Execute(ctx context.Context, userInput Message, history []Message
 	// Store memory and streaming parameters for member execution
 	t.memory = memory
 	t.eventStream = eventStream
+	t.executed = 0
 
 	teamTracker := NewOperationTracker(t.Recorder, ctx, "TeamExecution", t.FullName(), map[string]string{
 		"strategy":    t.Strategy,
@@ -59,13 +80,88 @@ func (t *Team) Execute(ctx context.Context, userInput Message, history []Message
 		execFunc = t.executeSelector
 	case "graph":
 		execFunc = t.executeGraph
+	case "parallel":
+		execFunc = t.executeParallel
+	case "router":
+		execFunc = t.executeRouter
 	default:
 		err := fmt.Errorf("unsupported strategy %s for team %s", t.Strategy, t.FullName())
 		teamTracker.Fail(err)
 		return nil, err
 	}
 
-	return t.executeWithTracking(teamTracker, execFunc, ctx, userInput, history)
+	if t.Retries > 0 {
+		execFunc = t.withTeamRetries(execFunc)
+	}
+	// The timeout wraps the retries so it bounds the whole run, not each attempt
+	if t.Timeout > 0 {
+		execFunc = t.withTeamTimeout(execFunc)
+	}
+
+	t.startRun()
+	start := time.Now()
+
+	messages, tokenUsage, err := t.executeWithTracking(teamTracker, execFunc, ctx, userInput, history)
+
+	t.finishRun(time.Since(start), tokenUsage, err)
+	return messages, err
+}
+
+// ExecuteStream runs the team like Execute, emitting each member's result as soon as the member finishes.
+// Teams with retries emit the results of an attempt once it ends, so results of failed attempts are never seen.
+// If the team itself fails, a final result with an empty Member carries the error. The channel is closed when the run ends.
+func (t *Team) ExecuteStream(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) <-chan MemberResult {
+	results := make(chan MemberResult, len(t.Members))
+	t.results = results
+
+	go func() {
+		defer close(results)
+		defer func() { t.results = nil }()
+
+		if _, err := t.Execute(ctx, userInput, history, memory, eventStream); err != nil {
+			t.sendMemberResult(ctx, MemberResult{Err: err})
+		}
+	}()
+
+	return results
+}
+
+func (t *Team) sendMemberResult(ctx context.Context, result MemberResult) {
+	if t.results == nil {
+		return
+	}
+	if t.heldResults != nil {
+		t.heldResults.add(result)
+		return
+	}
+	select {
+	case t.results <- result:
+	case <-ctx.Done():
+	}
+}
+
+// withTeamTimeout bounds the whole team run by the team timeout. When the deadline is hit the
+// messages accumulated so far are returned with a TeamTimeout event instead of a context error.
+func (t *Team) withTeamTimeout(execFunc func(context.Context, Message, []Message) ([]Message, error)) func(context.Context, Message, []Message) ([]Message, error) {
+	return func(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+		teamCtx, cancel := context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+
+		result, err := execFunc(teamCtx, userInput, history)
+		if err != nil && errors.Is(teamCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			t.Recorder.EmitEvent(ctx, corev1.EventTypeWarning, "TeamTimeout", BaseEvent{
+				Name: t.FullName(),
+				Metadata: map[string]string{
+					"strategy": t.Strategy,
+					"timeout":  t.Timeout.String(),
+					"teamName": t.FullName(),
+					"messages": fmt.Sprintf("%d", len(result)),
+				},
+			})
+			return result, nil
+		}
+		return result, err
+	}
 }
 
 func (t *Team) executeSequential(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
@@ -147,6 +243,11 @@ func (t *Team) executeRoundRobin(ctx context.Context, userInput Message, history
 	}
 }
 
+// GraphPath returns the members executed by the last graph execution, in order
+func (t *Team) GraphPath() []string {
+	return slices.Clone(t.graphPath)
+}
+
 func (t *Team) GetName() string {
 	return t.Name
 }
@@ -165,17 +266,34 @@ func MakeTeam(ctx context.Context, k8sClient client.Client, crd *arkv1alpha1.Tea
 		return nil, err
 	}
 
+	var timeout time.Duration
+	if crd.Spec.Timeout != nil {
+		timeout = crd.Spec.Timeout.Duration
+	}
+	var retries int
+	if crd.Spec.Retries != nil {
+		retries = *crd.Spec.Retries
+	}
+	var retryBackoff time.Duration
+	if crd.Spec.RetryBackoff != nil {
+		retryBackoff = crd.Spec.RetryBackoff.Duration
+	}
+
 	return &Team{
-		Name:        crd.Name,
-		Members:     members,
-		Strategy:    crd.Spec.Strategy,
-		Description: crd.Spec.Description,
-		MaxTurns:    crd.Spec.MaxTurns,
-		Selector:    crd.Spec.Selector,
-		Graph:       crd.Spec.Graph,
-		Recorder:    recorder,
-		Client:      k8sClient,
-		Namespace:   crd.Namespace,
+		Name:         crd.Name,
+		Members:      members,
+		Strategy:     crd.Spec.Strategy,
+		Description:  crd.Spec.Description,
+		MaxTurns:     crd.Spec.MaxTurns,
+		Timeout:      timeout,
+		Retries:      retries,
+		RetryBackoff: retryBackoff,
+		Selector:     crd.Spec.Selector,
+		Graph:        crd.Spec.Graph,
+		Router:       crd.Spec.Router,
+		Recorder:     recorder,
+		Client:       k8sClient,
+		Namespace:    crd.Namespace,
 	}, nil
 }
 
@@ -193,19 +311,19 @@ func loadTeamMembers(ctx context.Context, k8sClient client.Client, crd *arkv1alp
 	return members, nil
 }
 
-func (t *Team) executeWithTracking(tracker *OperationTracker, execFunc func(context.Context, Message, []Message) ([]Message, error), ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+// executeWithTracking runs the strategy and returns its messages along with the tokens the team used
+func (t *Team) executeWithTracking(tracker *OperationTracker, execFunc func(context.Context, Message, []Message) ([]Message, error), ctx context.Context, userInput Message, history []Message) ([]Message, TokenUsage, error) {
 	// Get the current token usage before team execution
-	var tokenCollector *TokenUsageCollector
-	if collector, ok := t.Recorder.(*TokenUsageCollector); ok {
-		tokenCollector = collector
-	}
-
+	tokenCollector := t.tokenCollector()
 	var initialTokens TokenUsage
 	if tokenCollector != nil {
 		initialTokens = tokenCollector.GetTokenSummary()
 	}
 
 	result, err := execFunc(ctx, userInput, history)
+	if err == nil && t.executed == 0 {
+		err = &NoMembersExecuted{Team: t.FullName()}
+	}
 
 	// Calculate token usage consumed by this team execution
 	var teamTokenUsage TokenUsage
@@ -221,10 +339,10 @@ func (t *Team) executeWithTracking(tracker *OperationTracker, execFunc func(cont
 	if err != nil {
 		if IsTerminateTeam(err) {
 			tracker.CompleteWithTermination(err.Error())
-			return result, err
+			return result, teamTokenUsage, err
 		}
 		tracker.Fail(err)
-		return result, err
+		return result, teamTokenUsage, err
 	}
 
 	if teamTokenUsage.TotalTokens > 0 {
@@ -232,11 +350,21 @@ func (t *Team) executeWithTracking(tracker *OperationTracker, execFunc func(cont
 	} else {
 		tracker.Complete("")
 	}
-	return result, err
+	return result, teamTokenUsage, err
 }
 
 // executeMemberAndAccumulate executes a member and accumulates new messages
 func (t *Team) executeMemberAndAccumulate(ctx context.Context, member TeamMember, userInput Message, messages, newMessages *[]Message, turn int) error {
+	t.executed++
+	memberNewMessages, err := t.executeMember(ctx, member, userInput, *messages, turn)
+	// Messages are accumulated even on error
+	*messages = append(*messages, memberNewMessages...)
+	*newMessages = append(*newMessages, memberNewMessages...)
+	return err
+}
+
+// executeMember runs a single member turn with tracking and reports its result to ExecuteStream
+func (t *Team) executeMember(ctx context.Context, member TeamMember, userInput Message, history []Message, turn int) ([]Message, error) {
 	// Add team and current member to execution metadata for streaming
 	ctx = WithExecutionMetadata(ctx, map[string]interface{}{
 		"team":  t.Name,
@@ -252,23 +380,88 @@ func (t *Team) executeMemberAndAccumulate(ctx context.Context, member TeamMember
 		"strategy":   t.Strategy,
 	})
 
-	memberNewMessages, err := member.Execute(ctx, userInput, *messages, t.memory, t.eventStream)
+	ctx, span := telemetry.StartTeamMember(ctx, t.FullName(), member.GetName(), member.GetType(), t.Strategy, turn)
+	defer span.End()
+
+	// Concurrent members share the collector, so the token delta of one member would include the others
+	tokenCollector := t.tokenCollector()
+	if runsConcurrently(ctx) {
+		tokenCollector = nil
+	}
+	var initialTokens TokenUsage
+	if tokenCollector != nil {
+		initialTokens = tokenCollector.GetTokenSummary()
+	}
+	start := time.Now()
+
+	memberNewMessages, err := member.Execute(ctx, userInput, history, t.memory, t.eventStream)
+
+	duration := time.Since(start)
+	telemetry.RecordTeamMemberDuration(span, duration)
+	runTurn := TeamRunTurn{
+		Member:     member.GetName(),
+		MemberType: member.GetType(),
+		Turn:       turn,
+		Duration:   duration.String(),
+		Messages:   len(memberNewMessages),
+		ToolCalls:  toolCallNames(memberNewMessages),
+	}
+	if tokenCollector != nil {
+		finalTokens := tokenCollector.GetTokenSummary()
+		runTurn.TokenUsage = &TokenUsage{
+			PromptTokens:     finalTokens.PromptTokens - initialTokens.PromptTokens,
+			CompletionTokens: finalTokens.CompletionTokens - initialTokens.CompletionTokens,
+			TotalTokens:      finalTokens.TotalTokens - initialTokens.TotalTokens,
+		}
+		telemetry.AddTokenUsage(span, runTurn.TokenUsage.PromptTokens, runTurn.TokenUsage.CompletionTokens, runTurn.TokenUsage.TotalTokens)
+	}
+	if err != nil {
+		if IsTerminateTeam(err) {
+			runTurn.Terminated = true
+		} else {
+			runTurn.Error = err.Error()
+		}
+	}
+	if t.run != nil {
+		t.run.addTurn(runTurn)
+	}
+
+	result := MemberResult{Member: member.GetName(), Turn: turn, Messages: memberNewMessages}
 	if err != nil {
 		if IsTerminateTeam(err) {
 			memberTracker.CompleteWithTermination(err.Error())
+			telemetry.RecordSuccess(span)
 		} else {
 			memberTracker.Fail(err)
+			telemetry.RecordError(span, err)
+			result.Err = err
 		}
-		// Still accumulate messages even on error
-		*messages = append(*messages, memberNewMessages...)
-		*newMessages = append(*newMessages, memberNewMessages...)
-		return err
+		t.sendMemberResult(ctx, result)
+		return memberNewMessages, err
 	}
 
 	memberTracker.Complete("")
-	*messages = append(*messages, memberNewMessages...)
-	*newMessages = append(*newMessages, memberNewMessages...)
-	return nil
+	telemetry.RecordSuccess(span)
+	t.sendMemberResult(ctx, result)
+	return memberNewMessages, nil
+}
+
+const concurrentTeamMembersKey contextKey = "concurrentTeamMembers"
+
+// withConcurrentTeamMembers marks team members run with the context as running alongside other members
+func withConcurrentTeamMembers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, concurrentTeamMembersKey, true)
+}
+
+func runsConcurrently(ctx context.Context) bool {
+	concurrent, _ := ctx.Value(concurrentTeamMembersKey).(bool)
+	return concurrent
+}
+
+// tokenCollector returns the team's recorder when it collects token usage
+func (t *Team) tokenCollector() *TokenUsageCollector {
+	collector, _ := t.Recorder.(*TokenUsageCollector)
+	return collector
 }
 
 func loadTeamMember(ctx context.Context, k8sClient client.Client, memberSpec arkv1alpha1.TeamMember, namespace, teamName string, recorder EventEmitter) (TeamMember, error) {
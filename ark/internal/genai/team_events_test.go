@@ -0,0 +1,77 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+	"mckinsey.com/ark/internal/genai/genaitest"
+)
+
+type echoMember struct {
+	name string
+}
+
+func (m *echoMember) Execute(_ context.Context, _ genai.Message, _ []genai.Message, _ genai.MemoryInterface, _ genai.EventStreamInterface) ([]genai.Message, error) {
+	return []genai.Message{genai.NewAssistantMessage("response from " + m.name)}, nil
+}
+
+func (m *echoMember) GetName() string        { return m.name }
+func (m *echoMember) GetType() string        { return "agent" }
+func (m *echoMember) GetDescription() string { return "" }
+
+func newEventTestTeam(strategy string, recorder genai.EventEmitter, maxTurns int) *genai.Team {
+	return &genai.Team{
+		Name:      "events",
+		Namespace: "default",
+		Strategy:  strategy,
+		MaxTurns:  &maxTurns,
+		Recorder:  recorder,
+		Members:   []genai.TeamMember{&echoMember{name: "a"}, &echoMember{name: "b"}},
+	}
+}
+
+func TestRoundRobinTeamEvents(t *testing.T) {
+	recorder := genaitest.NewRecorder()
+	team := newEventTestTeam("round-robin", recorder, 3)
+
+	_, err := team.Execute(context.Background(), genai.NewUserMessage("hello"), nil, genai.NewNoopMemory(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"TeamExecutionStart",
+		"TeamMemberStart", "TeamMemberComplete",
+		"TeamMemberStart", "TeamMemberComplete",
+		"TeamMemberStart", "TeamMemberComplete",
+		"TeamTurnMaxTurns", "TeamMaxTurnsReached",
+		"TeamExecutionComplete",
+	}, recorder.Reasons())
+
+	maxTurns := recorder.EventsFor("TeamMaxTurnsReached")
+	require.Len(t, maxTurns, 1)
+	assert.Equal(t, "Warning", maxTurns[0].Type)
+	assert.Equal(t, "3", maxTurns[0].Data.(genai.BaseEvent).Metadata["maxTurns"])
+}
+
+func TestGraphTeamEvents(t *testing.T) {
+	recorder := genaitest.NewRecorder()
+	team := newEventTestTeam("graph", recorder, 2)
+	team.Graph = &arkv1alpha1.TeamGraphSpec{Edges: []arkv1alpha1.TeamGraphEdge{{From: "a", To: "b"}, {From: "b", To: "a"}}}
+
+	_, err := team.Execute(context.Background(), genai.NewUserMessage("hello"), nil, genai.NewNoopMemory(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"ParticipantSelected", "ParticipantSelected", "TeamMaxTurnsReached", "TeamGraphPath",
+	}, recorder.Reasons("ParticipantSelected", "TeamMaxTurnsReached", "TeamGraphPath"))
+
+	selected := recorder.EventsFor("ParticipantSelected")
+	assert.Equal(t, "a", selected[0].Data.(genai.ExecutionEvent).Metadata["selected_participant"])
+	assert.Equal(t, "b", selected[1].Data.(genai.ExecutionEvent).Metadata["selected_participant"])
+}
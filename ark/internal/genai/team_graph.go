@@ -3,10 +3,20 @@ package genai
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 )
 
+// executeGraph walks the graph from the first member. Each step runs the members that are ready,
+// which is a single member unless graph.maxConcurrency allows several outgoing edges, in which case
+// up to maxConcurrency of them run at once against the same history. MaxTurns bounds the total
+// number of member executions and a member terminating the team stops the whole graph.
 func (t *Team) executeGraph(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
 	if len(t.Members) == 0 {
 		return nil, fmt.Errorf("team %s has no members for graph execution", t.FullName())
@@ -20,43 +30,80 @@ func (t *Team) executeGraph(ctx context.Context, userInput Message, history []Me
 		memberMap[member.GetName()] = member
 	}
 
-	transitionMap := make(map[string]string)
+	maxConcurrency := 1
+	transitionMap := make(map[string][]arkv1alpha1.TeamGraphEdge)
 	if t.Graph != nil {
 		for _, edge := range t.Graph.Edges {
-			transitionMap[edge.From] = edge.To
+			transitionMap[edge.From] = append(transitionMap[edge.From], edge)
+		}
+		if t.Graph.MaxConcurrency != nil && *t.Graph.MaxConcurrency > 1 {
+			maxConcurrency = *t.Graph.MaxConcurrency
 		}
 	}
 
 	turnTracker := NewExecutionRecorder(t.Recorder)
 	turnTracker.TeamTurn(ctx, "Start", t.FullName(), t.Strategy, 0)
 
-	currentMemberName := t.Members[0].GetName()
-
-	for turns := 0; ; turns++ {
-		member, exists := memberMap[currentMemberName]
-		if !exists {
-			return newMessages, fmt.Errorf("member %s not found in team %s", currentMemberName, t.FullName())
-		}
+	t.graphPath = nil
+	var firedEdges []string
+	defer func() {
+		turnTracker.GraphPath(ctx, t.FullName(), t.graphPath, firedEdges)
+	}()
 
-		memberTracker := NewExecutionRecorder(t.Recorder)
-		memberTracker.ParticipantSelected(ctx, t.FullName(), currentMemberName, "graph")
+	ready := []string{t.Members[0].GetName()}
+	turns := 0
+	lastStep := false
 
-		if err := t.executeMemberAndAccumulate(ctx, member, userInput, &messages, &newMessages, turns); err != nil {
-			if IsTerminateTeam(err) {
-				return newMessages, nil
+	for len(ready) > 0 {
+		step := make([]TeamMember, 0, len(ready))
+		for _, name := range ready {
+			member, exists := memberMap[name]
+			if !exists {
+				return newMessages, fmt.Errorf("member %s not found in team %s", name, t.FullName())
 			}
-			return newMessages, err
+			step = append(step, member)
 		}
 
-		nextMember := transitionMap[currentMemberName]
-		if nextMember == "" {
+		stepMessages, terminated, err := t.executeGraphStep(ctx, userInput, messages, step, turns, maxConcurrency)
+		turns += len(step)
+		for _, memberMessages := range stepMessages {
+			messages = append(messages, memberMessages...)
+			newMessages = append(newMessages, memberMessages...)
+		}
+		if terminated {
+			return newMessages, nil
+		}
+		if err != nil {
+			return newMessages, err
+		}
+		if lastStep {
 			break
 		}
 
-		currentMemberName = nextMember
+		// Successors reached from several members of this step run once
+		var next []string
+		incoming := make(map[string][]string)
+		for i, member := range step {
+			output := lastAssistantContent(stepMessages[i])
+			for _, edge := range transitionMap[member.GetName()] {
+				if matched, reason := graphEdgeConditionMatches(edge.Condition, output); !matched {
+					turnTracker.GraphEdgeSkipped(ctx, t.FullName(), edge.From, edge.To, reason)
+					continue
+				}
+				if _, seen := incoming[edge.To]; !seen {
+					next = append(next, edge.To)
+				}
+				incoming[edge.To] = append(incoming[edge.To], edge.From+"->"+edge.To)
+			}
+		}
+		if len(next) == 0 {
+			break
+		}
 
-		if t.MaxTurns != nil && turns+1 >= *t.MaxTurns {
-			turnTracker.TeamTurn(ctx, "MaxTurns", t.FullName(), t.Strategy, turns+1)
+		if t.MaxTurns != nil && turns+len(next) > *t.MaxTurns {
+			// Report the limit as soon as ready members are dropped, since the ones that still fit may
+			// have no successors and end the graph without reaching this check again
+			turnTracker.TeamTurn(ctx, "MaxTurns", t.FullName(), t.Strategy, turns)
 			// Log the maxTurns limit for observability, but return success with accumulated messages
 			t.Recorder.EmitEvent(ctx, corev1.EventTypeWarning, "TeamMaxTurnsReached", BaseEvent{
 				Name: t.FullName(),
@@ -66,9 +113,134 @@ func (t *Team) executeGraph(ctx context.Context, userInput Message, history []Me
 					"teamName": t.FullName(),
 				},
 			})
-			return newMessages, nil
+			remaining := *t.MaxTurns - turns
+			if remaining <= 0 {
+				return newMessages, nil
+			}
+			// Run the successors that still fit, then stop
+			next = next[:remaining]
+			lastStep = true
+		}
+
+		for _, to := range next {
+			firedEdges = append(firedEdges, incoming[to]...)
 		}
+		ready = next
 	}
 
 	return newMessages, nil
 }
+
+// executeGraphStep runs the ready members, at most maxConcurrency at a time, against the same history.
+// Messages are returned in member order. The first error or termination cancels the members still running.
+func (t *Team) executeGraphStep(ctx context.Context, userInput Message, history []Message, step []TeamMember, turn, maxConcurrency int) ([][]Message, bool, error) {
+	stepMessages := make([][]Message, len(step))
+
+	if len(step) == 1 {
+		memberTracker := NewExecutionRecorder(t.Recorder)
+		memberTracker.ParticipantSelected(ctx, t.FullName(), step[0].GetName(), "graph")
+		t.graphPath = append(t.graphPath, step[0].GetName())
+		t.executed++
+
+		messages, err := t.executeMember(ctx, step[0], userInput, history, turn)
+		stepMessages[0] = messages
+		if IsTerminateTeam(err) {
+			return stepMessages, true, nil
+		}
+		return stepMessages, false, err
+	}
+
+	stepCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if maxConcurrency > 1 {
+		stepCtx = withConcurrentTeamMembers(stepCtx)
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		firstErr   error
+		terminated bool
+	)
+	slots := make(chan struct{}, maxConcurrency)
+
+	// Only members that got a slot before the step was cancelled are part of the path
+	started := make([]bool, len(step))
+	for i, member := range step {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-stepCtx.Done():
+				return
+			}
+			if stepCtx.Err() != nil {
+				return
+			}
+			started[i] = true
+			memberTracker := NewExecutionRecorder(t.Recorder)
+			memberTracker.ParticipantSelected(ctx, t.FullName(), member.GetName(), "graph")
+
+			messages, err := t.executeMember(stepCtx, member, userInput, slices.Clone(history), turn+i)
+			stepMessages[i] = messages
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if terminated || firstErr != nil {
+				return
+			}
+			if IsTerminateTeam(err) {
+				terminated = true
+			} else {
+				firstErr = err
+			}
+			cancel()
+		}()
+	}
+	wg.Wait()
+
+	for i, member := range step {
+		if started[i] {
+			t.graphPath = append(t.graphPath, member.GetName())
+			t.executed++
+		}
+	}
+	return stepMessages, terminated, firstErr
+}
+
+// graphEdgeConditionMatches reports whether an edge is taken for the output of its from member,
+// and otherwise which part of the condition did not match
+func graphEdgeConditionMatches(condition *arkv1alpha1.TeamGraphEdgeCondition, output string) (bool, string) {
+	if condition == nil {
+		return true, ""
+	}
+	if condition.Contains != "" && !strings.Contains(strings.ToLower(output), strings.ToLower(condition.Contains)) {
+		return false, fmt.Sprintf("output does not contain %q", condition.Contains)
+	}
+	if condition.Matches != "" {
+		re, err := regexp.Compile(condition.Matches)
+		if err != nil {
+			return false, fmt.Sprintf("invalid pattern %q: %v", condition.Matches, err)
+		}
+		if !re.MatchString(output) {
+			return false, fmt.Sprintf("output does not match %q", condition.Matches)
+		}
+	}
+	return true, ""
+}
+
+// lastAssistantContent returns the text of the last assistant message, or an empty string if there is none
+func lastAssistantContent(messages []Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if m := messages[i].OfAssistant; m != nil {
+			return m.Content.OfString.Value
+		}
+	}
+	return ""
+}
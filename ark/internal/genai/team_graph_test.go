@@ -0,0 +1,290 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestExecuteGraphRecordsPath(t *testing.T) {
+	recorder := &mockRecorder{}
+	team := newTestTeam("graph", recorder,
+		&fakeTeamMember{name: "planner"},
+		&fakeTeamMember{name: "researcher"},
+		&fakeTeamMember{name: "writer"},
+	)
+	maxTurns := 10
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "researcher", To: "writer"},
+		},
+	}
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 3)
+	assert.Equal(t, []string{"planner", "researcher", "writer"}, team.GraphPath())
+
+	event, ok := recorder.eventFor("TeamGraphPath").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "planner -> researcher -> writer", event.Metadata["path"])
+	assert.Equal(t, "planner->researcher,researcher->writer", event.Metadata["edges"])
+	assert.Equal(t, "3", event.Metadata["steps"])
+}
+
+func TestExecuteGraphPathStopsAtMaxTurns(t *testing.T) {
+	recorder := &mockRecorder{}
+	team := newTestTeam("graph", recorder,
+		&fakeTeamMember{name: "a"},
+		&fakeTeamMember{name: "b"},
+	)
+	maxTurns := 3
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "a", To: "b"},
+			{From: "b", To: "a"},
+		},
+	}
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "a"}, team.GraphPath())
+
+	event, ok := recorder.eventFor("TeamGraphPath").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "a->b,b->a", event.Metadata["edges"])
+}
+
+func TestExecuteGraphTraversalIsDeterministic(t *testing.T) {
+	edges := []arkv1alpha1.TeamGraphEdge{
+		{From: "e", To: "c"},
+		{From: "a", To: "d"},
+		{From: "d", To: "b"},
+		{From: "b", To: "e"},
+	}
+
+	var firstPath []string
+	for i := 0; i < 20; i++ {
+		team := newTestTeam("graph", &mockRecorder{},
+			&fakeTeamMember{name: "a"},
+			&fakeTeamMember{name: "b"},
+			&fakeTeamMember{name: "c"},
+			&fakeTeamMember{name: "d"},
+			&fakeTeamMember{name: "e"},
+		)
+		maxTurns := 10
+		team.MaxTurns = &maxTurns
+		team.Graph = &arkv1alpha1.TeamGraphSpec{Edges: edges}
+
+		_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+		require.NoError(t, err)
+
+		if i == 0 {
+			firstPath = team.GraphPath()
+			assert.Equal(t, []string{"a", "d", "b", "e", "c"}, firstPath)
+			continue
+		}
+		assert.Equal(t, firstPath, team.GraphPath())
+	}
+}
+
+// rendezvousMember only answers once every member sharing the barrier is running
+type rendezvousMember struct {
+	fakeTeamMember
+	barrier *sync.WaitGroup
+}
+
+func (m *rendezvousMember) Execute(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
+	m.barrier.Done()
+	m.barrier.Wait()
+	return m.fakeTeamMember.Execute(ctx, userInput, history, memory, eventStream)
+}
+
+func TestExecuteGraphRunsSuccessorsConcurrently(t *testing.T) {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	writer := &fakeTeamMember{name: "writer"}
+	team := &Team{
+		Name:      "test-team",
+		Namespace: "default",
+		Strategy:  "graph",
+		Recorder:  &mockRecorder{},
+		Members: []TeamMember{
+			&fakeTeamMember{name: "planner"},
+			&rendezvousMember{fakeTeamMember: fakeTeamMember{name: "researcher"}, barrier: &barrier},
+			&rendezvousMember{fakeTeamMember: fakeTeamMember{name: "critic"}, barrier: &barrier},
+			writer,
+		},
+	}
+	maxTurns, maxConcurrency := 10, 2
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		MaxConcurrency: &maxConcurrency,
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "planner", To: "critic"},
+			{From: "researcher", To: "writer"},
+			{From: "critic", To: "writer"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	messages, err := team.Execute(ctx, NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 4)
+	assert.Equal(t, "response from researcher", messages[1].OfAssistant.Content.OfString.Value)
+	assert.Equal(t, "response from critic", messages[2].OfAssistant.Content.OfString.Value)
+	assert.Equal(t, []string{"planner", "researcher", "critic", "writer"}, team.GraphPath())
+	assert.Equal(t, 1, writer.calls)
+}
+
+func TestExecuteGraphConcurrentStepStopsOnError(t *testing.T) {
+	failure := errors.New("boom")
+	writer := &fakeTeamMember{name: "writer"}
+	team := newTestTeam("graph", &mockRecorder{},
+		&fakeTeamMember{name: "planner"},
+		&fakeTeamMember{name: "researcher", block: true},
+		&fakeTeamMember{name: "critic", err: failure},
+		writer,
+	)
+	maxTurns, maxConcurrency := 10, 2
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		MaxConcurrency: &maxConcurrency,
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "planner", To: "critic"},
+			{From: "critic", To: "writer"},
+		},
+	}
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.ErrorIs(t, err, failure)
+	assert.Equal(t, 0, writer.calls)
+}
+
+func TestExecuteGraphMaxTurnsBoundsConcurrentSteps(t *testing.T) {
+	recorder := &mockRecorder{}
+	team := newTestTeam("graph", recorder,
+		&fakeTeamMember{name: "planner"},
+		&fakeTeamMember{name: "researcher"},
+		&fakeTeamMember{name: "critic"},
+	)
+	maxTurns, maxConcurrency := 2, 2
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		MaxConcurrency: &maxConcurrency,
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "planner", To: "critic"},
+		},
+	}
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, []string{"planner", "researcher"}, team.GraphPath())
+	// The dropped member is reported even though the one that ran has no successors
+	assert.NotNil(t, recorder.eventFor("TeamMaxTurnsReached"))
+}
+
+func TestExecuteGraphPathSkipsMembersCancelledBeforeStart(t *testing.T) {
+	failure := errors.New("boom")
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	members := []*rendezvousMember{
+		{fakeTeamMember: fakeTeamMember{name: "researcher", err: failure}, barrier: &barrier},
+		{fakeTeamMember: fakeTeamMember{name: "critic", err: failure}, barrier: &barrier},
+		{fakeTeamMember: fakeTeamMember{name: "reviewer", err: failure}, barrier: &barrier},
+	}
+	team := &Team{
+		Name:      "test-team",
+		Namespace: "default",
+		Strategy:  "graph",
+		Recorder:  &mockRecorder{},
+		Members:   []TeamMember{&fakeTeamMember{name: "planner"}, members[0], members[1], members[2]},
+	}
+	maxTurns, maxConcurrency := 10, 2
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		MaxConcurrency: &maxConcurrency,
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "planner", To: "critic"},
+			{From: "planner", To: "reviewer"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := team.Execute(ctx, NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.ErrorIs(t, err, failure)
+
+	// Both members holding a slot fail, so the third is cancelled while waiting for one
+	var path []string
+	for _, member := range members {
+		if member.calls > 0 {
+			path = append(path, member.name)
+		}
+	}
+	require.Len(t, path, 2)
+	assert.Equal(t, append([]string{"planner"}, path...), team.GraphPath())
+	assert.Equal(t, 3, team.executed)
+}
+
+func TestExecuteGraphConditionalEdges(t *testing.T) {
+	recorder := &mockRecorder{}
+	billing := &fakeTeamMember{name: "billing"}
+	team := newTestTeam("graph", recorder,
+		&fakeTeamMember{name: "classifier", reply: "Category: REFUND"},
+		&fakeTeamMember{name: "refund"},
+		billing,
+		&fakeTeamMember{name: "logger"},
+	)
+	maxTurns := 5
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "classifier", To: "refund", Condition: &arkv1alpha1.TeamGraphEdgeCondition{Contains: "refund"}},
+			{From: "classifier", To: "billing", Condition: &arkv1alpha1.TeamGraphEdgeCondition{Matches: `(?i)category: billing`}},
+			{From: "classifier", To: "logger"},
+		},
+	}
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("I want my money back"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 3)
+	assert.Equal(t, []string{"classifier", "refund", "logger"}, team.GraphPath())
+	assert.Equal(t, team.GraphPath(), team.Run().GraphPath)
+	assert.Equal(t, 0, billing.calls)
+
+	event, ok := recorder.eventFor("TeamGraphEdgeSkipped").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "billing", event.Metadata["to"])
+	assert.Equal(t, `output does not match "(?i)category: billing"`, event.Metadata["reason"])
+}
+
+func TestGraphEdgeConditionMatches(t *testing.T) {
+	matched, _ := graphEdgeConditionMatches(nil, "")
+	assert.True(t, matched)
+
+	matched, _ = graphEdgeConditionMatches(&arkv1alpha1.TeamGraphEdgeCondition{Contains: "Refund", Matches: `^yes`}, "yes, a refund")
+	assert.True(t, matched)
+
+	matched, reason := graphEdgeConditionMatches(&arkv1alpha1.TeamGraphEdgeCondition{Contains: "refund", Matches: `^yes`}, "no refund")
+	assert.False(t, matched)
+	assert.Equal(t, `output does not match "^yes"`, reason)
+}
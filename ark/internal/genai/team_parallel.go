@@ -0,0 +1,63 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// executeParallel runs every member concurrently against the same input and history. All members
+// share turn 0 and their messages are returned in member order. The first member error cancels
+// the members still running and is returned once they have stopped.
+func (t *Team) executeParallel(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+	memberCtx, cancel := context.WithCancel(withConcurrentTeamMembers(ctx))
+	defer cancel()
+
+	memberMessages := make([][]Message, len(t.Members))
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	t.executed = len(t.Members)
+	for i, member := range t.Members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			messages, err := t.executeMember(memberCtx, member, userInput, slices.Clone(history), 0)
+			memberMessages[i] = messages
+			if err == nil || IsTerminateTeam(err) {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if firstErr != nil {
+				return
+			}
+			firstErr = fmt.Errorf("agent %s failed in team %s: %w", member.GetName(), t.FullName(), err)
+			cancel()
+			t.Recorder.EmitEvent(ctx, corev1.EventTypeWarning, "TeamMemberFailed", BaseEvent{
+				Name: member.GetName(),
+				Metadata: map[string]string{
+					"error":       err.Error(),
+					"memberIndex": fmt.Sprintf("%d", i),
+					"strategy":    t.Strategy,
+					"teamName":    t.FullName(),
+				},
+			})
+		}()
+	}
+	wg.Wait()
+
+	var newMessages []Message
+	for _, messages := range memberMessages {
+		newMessages = append(newMessages, messages...)
+	}
+	return newMessages, firstErr
+}
@@ -0,0 +1,107 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+// DefaultTeamRetryBackoff is the wait before the first team retry when no backoff is configured
+const DefaultTeamRetryBackoff = time.Second
+
+// withTeamRetries re-runs the whole team from the original history when a run fails with a transient
+// error. Messages and run turns of failed runs are discarded. Each retry waits the backoff, doubled on
+// every further retry, takes one retry from the context's budget and emits a TeamRetry event.
+func (t *Team) withTeamRetries(execFunc func(context.Context, Message, []Message) ([]Message, error)) func(context.Context, Message, []Message) ([]Message, error) {
+	return func(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+		backoff := t.RetryBackoff
+		if backoff <= 0 {
+			backoff = DefaultTeamRetryBackoff
+		}
+
+		for attempt := 1; ; attempt++ {
+			t.executed = 0
+			if attempt > 1 {
+				t.startRun()
+			}
+			if t.results != nil {
+				t.heldResults = &memberResultBuffer{}
+			}
+			result, err := execFunc(ctx, userInput, slices.Clone(history))
+			if err == nil || attempt > t.Retries || ctx.Err() != nil || !isTransientTeamError(err) ||
+				!AllowRetry(ctx, "team retry for "+t.FullName()) {
+				t.releaseHeldResults(ctx)
+				return result, err
+			}
+			t.heldResults = nil
+
+			t.Recorder.EmitEvent(ctx, corev1.EventTypeWarning, "TeamRetry", BaseEvent{
+				Name: t.FullName(),
+				Metadata: map[string]string{
+					"strategy": t.Strategy,
+					"attempt":  fmt.Sprintf("%d", attempt),
+					"retries":  fmt.Sprintf("%d", t.Retries),
+					"backoff":  backoff.String(),
+					"error":    err.Error(),
+					"teamName": t.FullName(),
+				},
+			})
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return result, err
+			}
+			backoff *= 2
+		}
+	}
+}
+
+// memberResultBuffer holds the member results of a team attempt until it is known whether the attempt is retried
+type memberResultBuffer struct {
+	mu      sync.Mutex
+	results []MemberResult
+}
+
+func (b *memberResultBuffer) add(result MemberResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.results = append(b.results, result)
+}
+
+// releaseHeldResults sends the member results of the final attempt to ExecuteStream
+func (t *Team) releaseHeldResults(ctx context.Context) {
+	held := t.heldResults
+	t.heldResults = nil
+	if held == nil {
+		return
+	}
+	for _, result := range held.results {
+		t.sendMemberResult(ctx, result)
+	}
+}
+
+// isTransientTeamError reports whether a failed team run may succeed when run again, such as when a
+// member could not reach its model or server. Terminations and rejected requests are never retried.
+func isTransientTeamError(err error) bool {
+	if err == nil || IsTerminateTeam(err) {
+		return false
+	}
+	switch telemetry.ClassifyError(err) {
+	case telemetry.ErrorTypeConnection, telemetry.ErrorTypeTimeout, telemetry.ErrorTypeServer:
+		return true
+	case telemetry.ErrorTypeUnknown:
+		// Errors that lost their type on the way up are matched on their text
+		return isRetryableError(err) || isA2AConnectionError(err)
+	default:
+		return false
+	}
+}
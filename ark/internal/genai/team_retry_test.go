@@ -0,0 +1,145 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyMember fails with err for its first failures calls and answers afterwards
+type flakyMember struct {
+	fakeTeamMember
+	failures int
+}
+
+func (m *flakyMember) Execute(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
+	if m.calls < m.failures {
+		m.calls++
+		return nil, m.err
+	}
+	m.err = nil
+	return m.fakeTeamMember.Execute(ctx, userInput, history, memory, eventStream)
+}
+
+func TestTeamRetriesTransientFailure(t *testing.T) {
+	recorder := &mockRecorder{}
+	writer := &flakyMember{
+		fakeTeamMember: fakeTeamMember{name: "writer", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}},
+		failures:       1,
+	}
+	team := newTestTeam("sequential", recorder, &fakeTeamMember{name: "researcher"})
+	team.Members = append(team.Members, writer)
+	team.Retries = 2
+	team.RetryBackoff = time.Millisecond
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 2, "messages of the failed run are discarded")
+	assert.Equal(t, 2, writer.calls)
+	assert.Len(t, team.Run().Turns, 2, "turns of the failed run are discarded")
+
+	event, ok := recorder.eventFor("TeamRetry").(BaseEvent)
+	require.True(t, ok)
+	assert.Equal(t, "1", event.Metadata["attempt"])
+	assert.Equal(t, "1ms", event.Metadata["backoff"])
+}
+
+func TestTeamRetriesRateLimitedA2AMember(t *testing.T) {
+	assert.True(t, isTransientTeamError(fmt.Errorf("agent failed: %w", &A2AStatusError{StatusCode: http.StatusTooManyRequests, Method: "message/send"})))
+	assert.True(t, isTransientTeamError(&A2AStatusError{StatusCode: http.StatusServiceUnavailable, Method: "message/send"}))
+	assert.False(t, isTransientTeamError(&A2AStatusError{StatusCode: http.StatusBadRequest, Method: "message/send"}))
+
+	remote := &flakyMember{
+		fakeTeamMember: fakeTeamMember{name: "remote", err: &A2AStatusError{StatusCode: http.StatusTooManyRequests, Method: "message/send"}},
+		failures:       1,
+	}
+	team := newTestTeam("sequential", &mockRecorder{})
+	team.Members = append(team.Members, remote)
+	team.Retries = 1
+	team.RetryBackoff = time.Millisecond
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, remote.calls)
+}
+
+func TestTeamRetriesStopOnPermanentFailure(t *testing.T) {
+	recorder := &mockRecorder{}
+	broken := &fakeTeamMember{name: "broken", err: errors.New("invalid prompt")}
+	team := newTestTeam("sequential", recorder, broken)
+	team.Retries = 2
+	team.RetryBackoff = time.Millisecond
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, broken.calls)
+	assert.Nil(t, recorder.eventFor("TeamRetry"))
+}
+
+func TestTeamRetriesAreBounded(t *testing.T) {
+	unreachable := &fakeTeamMember{name: "unreachable", err: errors.New("dial tcp: connection refused")}
+	team := newTestTeam("sequential", &mockRecorder{}, unreachable)
+	team.Retries = 2
+	team.RetryBackoff = time.Millisecond
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+	assert.Equal(t, 3, unreachable.calls)
+}
+
+func TestTeamRetriesStreamOnlyFinalAttempt(t *testing.T) {
+	writer := &flakyMember{
+		fakeTeamMember: fakeTeamMember{name: "writer", err: errors.New("dial tcp: connection refused")},
+		failures:       1,
+	}
+	team := newTestTeam("sequential", &mockRecorder{}, &fakeTeamMember{name: "researcher"})
+	team.Members = append(team.Members, writer)
+	team.Retries = 1
+	team.RetryBackoff = time.Millisecond
+
+	var members []string
+	for result := range team.ExecuteStream(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil) {
+		require.NoError(t, result.Err)
+		members = append(members, result.Member)
+	}
+	assert.Equal(t, []string{"researcher", "writer"}, members)
+}
+
+// slowFailingMember fails with a connection error after a delay
+type slowFailingMember struct {
+	fakeTeamMember
+	delay time.Duration
+}
+
+func (m *slowFailingMember) Execute(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
+	m.calls++
+	select {
+	case <-time.After(m.delay):
+		return nil, errors.New("dial tcp: connection refused")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestTeamTimeoutBoundsRetries(t *testing.T) {
+	slow := &slowFailingMember{fakeTeamMember: fakeTeamMember{name: "slow"}, delay: 30 * time.Millisecond}
+	team := newTestTeam("sequential", &mockRecorder{})
+	team.Members = []TeamMember{slow}
+	team.Timeout = 50 * time.Millisecond
+	team.Retries = 5
+	team.RetryBackoff = time.Millisecond
+
+	start := time.Now()
+	_, _ = team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	assert.Less(t, time.Since(start), 150*time.Millisecond)
+	assert.Equal(t, 2, slow.calls, "the second attempt is cut off by the team timeout")
+}
@@ -0,0 +1,127 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+var routerPromptTemplate = template.Must(template.New("router").Parse(`Classify the input below and route it to one of the following roles:
+{{.Roles}}.
+Only return the role, one of {{.Participants}}.
+
+{{.History}}`))
+
+// executeRouter runs exactly one member, chosen from the user input by the router rules, the router agent or the default member
+func (t *Team) executeRouter(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+	turnTracker := NewExecutionRecorder(t.Recorder)
+	turnTracker.TeamTurn(ctx, "Start", t.FullName(), t.Strategy, 0)
+	defer turnTracker.TeamTurn(ctx, "Complete", t.FullName(), t.Strategy, 0)
+
+	member, err := t.routeMember(ctx, userInput)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := append([]Message{}, history...)
+	var newMessages []Message
+	if err := t.executeMemberAndAccumulate(ctx, member, userInput, &messages, &newMessages, 0); err != nil {
+		if IsTerminateTeam(err) {
+			return newMessages, nil
+		}
+		return newMessages, err
+	}
+	return newMessages, nil
+}
+
+// routeMember picks the member for the input, emitting ParticipantSelected with how it was chosen
+func (t *Team) routeMember(ctx context.Context, userInput Message) (TeamMember, error) {
+	if t.Router == nil {
+		return nil, fmt.Errorf("router strategy requires router configuration")
+	}
+
+	input := ""
+	if userInput.OfUser != nil {
+		input = userInput.OfUser.Content.OfString.Value
+	}
+
+	rec := NewExecutionRecorder(t.Recorder)
+
+	for _, rule := range t.Router.Rules {
+		condition := &arkv1alpha1.TeamGraphEdgeCondition{Contains: rule.Contains, Matches: rule.Matches}
+		if matched, _ := graphEdgeConditionMatches(condition, input); !matched {
+			continue
+		}
+		if member, ok := t.findMember(rule.Member); ok {
+			rec.ParticipantSelected(ctx, t.FullName(), member.GetName(), "router_rule")
+			return member, nil
+		}
+	}
+
+	if t.Router.Agent != "" {
+		selectedName, err := t.askRouterAgent(ctx, userInput)
+		if err != nil {
+			return nil, err
+		}
+		if member, ok := t.findMember(selectedName); ok {
+			rec.ParticipantSelected(ctx, t.FullName(), member.GetName(), "router_agent")
+			return member, nil
+		}
+	}
+
+	if member, ok := t.findMember(t.Router.DefaultMember); ok {
+		rec.ParticipantSelected(ctx, t.FullName(), member.GetName(), "router_default")
+		return member, nil
+	}
+
+	return nil, fmt.Errorf("team %s has no route for the input and no default member", t.FullName())
+}
+
+// askRouterAgent has the router agent classify the input and returns the member name it answered with
+func (t *Team) askRouterAgent(ctx context.Context, userInput Message) (string, error) {
+	routerAgent, err := t.loadTeamAgent(ctx, t.Router.Agent)
+	if err != nil {
+		return "", fmt.Errorf("failed to load router agent: %w", err)
+	}
+
+	var prompt bytes.Buffer
+	if err := routerPromptTemplate.Execute(&prompt, SelectorTemplateData{
+		Roles:        buildRoles(t.Members),
+		Participants: buildParticipants(t.Members),
+		History:      buildHistory([]Message{userInput}),
+	}); err != nil {
+		return "", err
+	}
+
+	response, err := routerAgent.Execute(ctx, NewUserMessage("Select the member to handle the input."), []Message{NewSystemMessage(prompt.String())}, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("router agent call failed: %w", err)
+	}
+	content := lastAssistantContent(response)
+	if content == "" {
+		return "", fmt.Errorf("router agent returned invalid response")
+	}
+
+	selectedName := strings.TrimSpace(content)
+	rec := NewExecutionRecorder(t.Recorder)
+	rec.SelectorAgentResponse(ctx, t.FullName(), routerAgent.Name, selectedName, buildParticipants(t.Members))
+	return selectedName, nil
+}
+
+func (t *Team) findMember(name string) (TeamMember, bool) {
+	if name == "" {
+		return nil, false
+	}
+	for _, member := range t.Members {
+		if member.GetName() == name {
+			return member, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,76 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func newRouterTestTeam(recorder EventEmitter, router *arkv1alpha1.TeamRouterSpec, members ...*fakeTeamMember) *Team {
+	team := newTestTeam("router", recorder, members...)
+	team.Router = router
+	return team
+}
+
+func TestRouterRunsFirstMatchingRuleOnly(t *testing.T) {
+	recorder := &mockRecorder{}
+	billing := &fakeTeamMember{name: "billing"}
+	support := &fakeTeamMember{name: "support"}
+	team := newRouterTestTeam(recorder, &arkv1alpha1.TeamRouterSpec{
+		Rules: []arkv1alpha1.TeamRouterRule{
+			{Member: "billing", Contains: "invoice"},
+			{Member: "support", Matches: "(?i)error|crash"},
+		},
+	}, billing, support)
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("The app shows an ERROR on my Invoice page"), nil, nil, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, messages, 1)
+	assert.Equal(t, 1, billing.calls)
+	assert.Equal(t, 0, support.calls)
+	selected, ok := recorder.eventFor("ParticipantSelected").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "billing", selected.Metadata["selected_participant"])
+	assert.Equal(t, "router_rule", selected.Metadata["selection_reason"])
+}
+
+func TestRouterFallsBackToDefaultMember(t *testing.T) {
+	recorder := &mockRecorder{}
+	billing := &fakeTeamMember{name: "billing"}
+	general := &fakeTeamMember{name: "general"}
+	team := newRouterTestTeam(recorder, &arkv1alpha1.TeamRouterSpec{
+		Rules:         []arkv1alpha1.TeamRouterRule{{Member: "billing", Contains: "invoice"}},
+		DefaultMember: "general",
+	}, billing, general)
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, nil, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, billing.calls)
+	assert.Equal(t, 1, general.calls)
+	require.Len(t, team.Run().Turns, 1)
+	assert.Equal(t, 0, team.Run().Turns[0].Turn, "a routed run is a single turn whichever member is chosen")
+	assert.NotNil(t, recorder.eventFor("TeamTurnComplete"))
+	selected, ok := recorder.eventFor("ParticipantSelected").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "router_default", selected.Metadata["selection_reason"])
+}
+
+func TestRouterFailsWithoutRouteOrDefault(t *testing.T) {
+	billing := &fakeTeamMember{name: "billing"}
+	team := newRouterTestTeam(&mockRecorder{}, &arkv1alpha1.TeamRouterSpec{
+		Rules: []arkv1alpha1.TeamRouterRule{{Member: "billing", Contains: "invoice"}},
+	}, billing)
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no route for the input")
+	assert.Equal(t, 0, billing.calls)
+}
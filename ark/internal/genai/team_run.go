@@ -0,0 +1,110 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+)
+
+// TeamRun is a machine-readable record of one team execution, independent of the tracing backend.
+// Token usage is left out when the team's recorder does not collect it.
+type TeamRun struct {
+	Team       string        `json:"team"`
+	Strategy   string        `json:"strategy"`
+	Members    []string      `json:"members"`
+	Turns      []TeamRunTurn `json:"turns"`
+	GraphPath  []string      `json:"graph_path,omitempty"`
+	Duration   string        `json:"duration,omitempty"`
+	TokenUsage *TokenUsage   `json:"token_usage,omitempty"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// TeamRunTurn records a single member turn. Token usage is only known when the team's recorder
+// collects it, and is left out for members that run concurrently and so share the collector.
+type TeamRunTurn struct {
+	Member     string      `json:"member"`
+	MemberType string      `json:"member_type"`
+	Turn       int         `json:"turn"`
+	Duration   string      `json:"duration"`
+	Messages   int         `json:"messages"`
+	ToolCalls  []string    `json:"tool_calls,omitempty"`
+	TokenUsage *TokenUsage `json:"token_usage,omitempty"`
+	Terminated bool        `json:"terminated,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// teamRunRecorder collects turns while a team runs; parallel members add turns concurrently
+type teamRunRecorder struct {
+	mu  sync.Mutex
+	run TeamRun
+}
+
+func (r *teamRunRecorder) addTurn(turn TeamRunTurn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.run.Turns = append(r.run.Turns, turn)
+}
+
+// Run returns the record of the last execution of the team
+func (t *Team) Run() TeamRun {
+	if t.run == nil {
+		return TeamRun{}
+	}
+	t.run.mu.Lock()
+	defer t.run.mu.Unlock()
+	run := t.run.run
+	run.Members = slices.Clone(run.Members)
+	run.Turns = slices.Clone(run.Turns)
+	run.GraphPath = slices.Clone(run.GraphPath)
+	return run
+}
+
+// RunJSON serializes the record of the last execution, for attaching to a query or writing to an artifact
+func (t *Team) RunJSON() ([]byte, error) {
+	return json.Marshal(t.Run())
+}
+
+// startRun begins a new run record for the team
+func (t *Team) startRun() {
+	members := make([]string, 0, len(t.Members))
+	for _, member := range t.Members {
+		members = append(members, member.GetName())
+	}
+	t.run = &teamRunRecorder{run: TeamRun{
+		Team:     t.FullName(),
+		Strategy: t.Strategy,
+		Members:  members,
+		Turns:    []TeamRunTurn{},
+	}}
+}
+
+// finishRun completes the run record once the strategy has returned
+func (t *Team) finishRun(duration time.Duration, tokenUsage TokenUsage, err error) {
+	t.run.mu.Lock()
+	defer t.run.mu.Unlock()
+	t.run.run.GraphPath = slices.Clone(t.graphPath)
+	t.run.run.Duration = duration.String()
+	if t.tokenCollector() != nil {
+		t.run.run.TokenUsage = &tokenUsage
+	}
+	if err != nil {
+		t.run.run.Error = err.Error()
+	}
+}
+
+// toolCallNames returns the names of the tools called in the messages, in order
+func toolCallNames(messages []Message) []string {
+	var names []string
+	for _, msg := range messages {
+		if msg.OfAssistant == nil {
+			continue
+		}
+		for _, call := range msg.OfAssistant.ToolCalls {
+			names = append(names, call.Function.Name)
+		}
+	}
+	return names
+}
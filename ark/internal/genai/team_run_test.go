@@ -0,0 +1,106 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestTeamRunRecordsTurns(t *testing.T) {
+	collector := NewTokenUsageCollector(&mockRecorder{})
+	first := &fakeTeamMember{name: "first", emitter: collector, usage: TokenUsage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}}
+	broken := &fakeTeamMember{name: "broken", err: errors.New("boom")}
+	team := newTestTeam("sequential", collector, first, broken)
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+
+	run := team.Run()
+	assert.Equal(t, "default/test-team", run.Team)
+	assert.Equal(t, "sequential", run.Strategy)
+	assert.Equal(t, []string{"first", "broken"}, run.Members)
+	assert.Equal(t, &TokenUsage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}, run.TokenUsage)
+	assert.Contains(t, run.Error, "boom")
+
+	require.Len(t, run.Turns, 2)
+	assert.Equal(t, "first", run.Turns[0].Member)
+	assert.Equal(t, 1, run.Turns[0].Messages)
+	assert.Equal(t, &TokenUsage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}, run.Turns[0].TokenUsage)
+	assert.Equal(t, "broken", run.Turns[1].Member)
+	assert.Equal(t, 1, run.Turns[1].Turn)
+	assert.Equal(t, "boom", run.Turns[1].Error)
+
+	data, err := team.RunJSON()
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "sequential", decoded["strategy"])
+	assert.Len(t, decoded["turns"], 2)
+}
+
+func TestTeamRunParallelAndToolCalls(t *testing.T) {
+	team := newTestTeam("parallel", &mockRecorder{},
+		&fakeTeamMember{name: "a"},
+		&fakeTeamMember{name: "b"},
+	)
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+
+	run := team.Run()
+	assert.Len(t, run.Turns, 2)
+	for _, turn := range run.Turns {
+		assert.Nil(t, turn.TokenUsage, "parallel members share the collector")
+	}
+	assert.Nil(t, run.TokenUsage, "the recorder does not collect token usage")
+
+	toolCall := Message(openai.ChatCompletionMessage{
+		Role: "assistant",
+		ToolCalls: []openai.ChatCompletionMessageToolCall{
+			{ID: "call-1", Type: "function", Function: openai.ChatCompletionMessageToolCallFunction{Name: "search", Arguments: "{}"}},
+		},
+	}.ToParam())
+	assert.Equal(t, []string{"search"}, toolCallNames([]Message{NewUserMessage("hi"), toolCall}))
+}
+
+func TestTeamRunConcurrentGraphStepTokens(t *testing.T) {
+	collector := NewTokenUsageCollector(&mockRecorder{})
+	usage := TokenUsage{PromptTokens: 1, CompletionTokens: 1, TotalTokens: 2}
+	team := newTestTeam("graph", collector,
+		&fakeTeamMember{name: "planner", emitter: collector, usage: usage},
+		&fakeTeamMember{name: "researcher", emitter: collector, usage: usage},
+		&fakeTeamMember{name: "critic", emitter: collector, usage: usage},
+	)
+	maxTurns, maxConcurrency := 10, 2
+	team.MaxTurns = &maxTurns
+	team.Graph = &arkv1alpha1.TeamGraphSpec{
+		MaxConcurrency: &maxConcurrency,
+		Edges: []arkv1alpha1.TeamGraphEdge{
+			{From: "planner", To: "researcher"},
+			{From: "planner", To: "critic"},
+		},
+	}
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+
+	run := team.Run()
+	require.Len(t, run.Turns, 3)
+	for _, turn := range run.Turns {
+		if turn.Member == "planner" {
+			assert.Equal(t, &usage, turn.TokenUsage)
+			continue
+		}
+		assert.Nil(t, turn.TokenUsage, "members of a concurrent step share the collector")
+	}
+	assert.Equal(t, &TokenUsage{PromptTokens: 3, CompletionTokens: 3, TotalTokens: 6}, run.TokenUsage)
+}
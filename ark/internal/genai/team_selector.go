@@ -3,14 +3,19 @@ package genai
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
 )
 
 const defaultSelectorPrompt = `You are in a role play game. The following roles are available:
@@ -51,78 +56,161 @@ func buildParticipants(members []TeamMember) string {
 func buildRoles(members []TeamMember) string {
 	var roles []string
 	for _, member := range members {
+		role := member.GetName()
 		if desc := member.GetDescription(); desc != "" {
-			roles = append(roles, member.GetName()+": "+desc)
-		} else {
-			roles = append(roles, member.GetName())
+			role += ": " + desc
+		}
+		if skills := describeSkills(memberSkills(member)); skills != "" {
+			role += " (skills: " + skills + ")"
 		}
+		roles = append(roles, role)
 	}
 	return strings.Join(roles, ", ")
 }
 
+// memberSkills returns the skills an agent member advertised through A2A discovery, or nil when none are known
+func memberSkills(member TeamMember) []A2ASkill {
+	agent, ok := member.(*Agent)
+	if !ok {
+		return nil
+	}
+	skillsJSON := agent.Annotations[annotations.A2AServerSkills]
+	if skillsJSON == "" {
+		return nil
+	}
+	var skills []A2ASkill
+	if err := json.Unmarshal([]byte(skillsJSON), &skills); err != nil {
+		return nil
+	}
+	return skills
+}
+
+func describeSkills(skills []A2ASkill) string {
+	descriptions := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		if skill.Description != nil && *skill.Description != "" {
+			descriptions = append(descriptions, skill.Name+" - "+*skill.Description)
+		} else {
+			descriptions = append(descriptions, skill.Name)
+		}
+	}
+	return strings.Join(descriptions, "; ")
+}
+
 func (t *Team) loadSelectorAgent(ctx context.Context) (*Agent, error) {
 	if t.Selector == nil || t.Selector.Agent == "" {
 		return nil, fmt.Errorf("selector agent must be specified")
 	}
 
-	agentName := t.Selector.Agent
+	return t.loadTeamAgent(ctx, t.Selector.Agent)
+}
 
+// loadTeamAgent loads an agent from the team's namespace that takes part in routing rather than as a member
+func (t *Team) loadTeamAgent(ctx context.Context, agentName string) (*Agent, error) {
 	var agentCRD arkv1alpha1.Agent
 	key := types.NamespacedName{Name: agentName, Namespace: t.Namespace}
 	if err := t.Client.Get(ctx, key, &agentCRD); err != nil {
-		return nil, fmt.Errorf("failed to get selector agent %s in namespace %s: %w", agentName, t.Namespace, err)
+		return nil, fmt.Errorf("failed to get agent %s in namespace %s: %w", agentName, t.Namespace, err)
 	}
 
 	agent, err := MakeAgent(ctx, t.Client, &agentCRD, t.Recorder)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create selector agent: %w", err)
+		return nil, fmt.Errorf("failed to create agent %s: %w", agentName, err)
 	}
 
 	return agent, nil
 }
 
-func (t *Team) selectMember(ctx context.Context, messages []Message, tmpl *template.Template, participantsList, rolesList, previousMember string) (TeamMember, int, error) {
-	history := buildHistory(messages)
+// RenderSelectorPrompt renders the selector prompt for the given conversation, as sent to the selector agent
+func (t *Team) RenderSelectorPrompt(messages []Message) (string, error) {
+	tmpl, err := t.parseSelectorTemplate()
+	if err != nil {
+		return "", err
+	}
+	return t.renderSelectorPrompt(tmpl, messages)
+}
+
+func (t *Team) parseSelectorTemplate() (*template.Template, error) {
+	promptTemplate := defaultSelectorPrompt
+	if t.Selector != nil && t.Selector.SelectorPrompt != "" {
+		promptTemplate = t.Selector.SelectorPrompt
+	}
+	return template.New("selector").Parse(promptTemplate)
+}
+
+func (t *Team) renderSelectorPrompt(tmpl *template.Template, messages []Message) (string, error) {
+	if t.Selector != nil && t.Selector.MaxHistoryMessages > 0 && len(messages) > t.Selector.MaxHistoryMessages {
+		messages = messages[len(messages)-t.Selector.MaxHistoryMessages:]
+	}
+
 	data := SelectorTemplateData{
-		Roles:        rolesList,
-		Participants: participantsList,
-		History:      history,
+		Roles:        buildRoles(t.Members),
+		Participants: buildParticipants(t.Members),
+		History:      buildHistory(messages),
 	}
 
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, data); err != nil {
-		return nil, 0, err
+		return "", err
 	}
+	return buf.String(), nil
+}
 
-	selectorAgent, err := t.loadSelectorAgent(ctx)
-	if err != nil {
-		return nil, 0, err
+// selectDefaultMember picks the configured default member, or the first member, when there is no conversation to route on
+func (t *Team) selectDefaultMember(ctx context.Context) (TeamMember, int, error) {
+	if len(t.Members) == 0 {
+		return nil, 0, fmt.Errorf("no members available")
 	}
 
-	response, err := selectorAgent.Execute(ctx, NewUserMessage("Select the next participant to respond."), []Message{NewSystemMessage(buf.String())}, nil, nil)
-	if err != nil {
-		return nil, 0, fmt.Errorf("selector agent call failed: %w", err)
+	index := 0
+	if t.Selector != nil && t.Selector.DefaultMember != "" {
+		for i, member := range t.Members {
+			if member.GetName() == t.Selector.DefaultMember {
+				index = i
+				break
+			}
+		}
 	}
 
-	if len(response) == 0 {
-		return nil, 0, fmt.Errorf("selector agent returned no messages")
+	rec := NewExecutionRecorder(t.Recorder)
+	rec.ParticipantSelected(ctx, t.FullName(), t.Members[index].GetName(), "cold_start")
+	return t.Members[index], index, nil
+}
+
+func (t *Team) selectMember(ctx context.Context, messages []Message, tmpl *template.Template, previousMember string, cache selectorCache) (TeamMember, int, error) {
+	if len(messages) == 0 {
+		return t.selectDefaultMember(ctx)
 	}
 
-	var selectedName string
-	lastMsg := response[len(response)-1]
-	if lastMsg.OfAssistant != nil && lastMsg.OfAssistant.Content.OfString.Value != "" {
-		selectedName = strings.TrimSpace(lastMsg.OfAssistant.Content.OfString.Value)
-	} else {
-		return nil, 0, fmt.Errorf("selector agent returned invalid response")
+	prompt, err := t.renderSelectorPrompt(tmpl, messages)
+	if err != nil {
+		return nil, 0, err
+	}
+	logf.FromContext(ctx).V(1).Info("selector prompt", "team", t.FullName(), "prompt", prompt)
+
+	// The prompt holds the recent conversation, so identical prompts are identical routing decisions
+	key := selectorCacheKey(prompt)
+	selectedName, cached := cache[key]
+	if !cached {
+		selectedName, err = t.askSelectorAgent(ctx, prompt)
+		if err != nil {
+			return nil, 0, err
+		}
+		if cache != nil {
+			cache[key] = selectedName
+		}
 	}
 
 	rec := NewExecutionRecorder(t.Recorder)
-	rec.SelectorAgentResponse(ctx, t.FullName(), selectorAgent.Name, selectedName, participantsList)
 
 	// Find selected member
 	for i, member := range t.Members {
 		if member.GetName() == selectedName {
-			rec.ParticipantSelected(ctx, t.FullName(), selectedName, "exact_match")
+			reason := "exact_match"
+			if cached {
+				reason = "cached_selection"
+			}
+			rec.ParticipantSelected(ctx, t.FullName(), selectedName, reason)
 			return member, i, nil
 		}
 	}
@@ -142,29 +230,65 @@ func (t *Team) selectMember(ctx context.Context, messages []Message, tmpl *templ
 	return nil, 0, fmt.Errorf("no members available")
 }
 
+// askSelectorAgent sends the rendered prompt to the selector agent and returns the member name it chose
+func (t *Team) askSelectorAgent(ctx context.Context, prompt string) (string, error) {
+	selectorAgent, err := t.loadSelectorAgent(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := selectorAgent.Execute(ctx, NewUserMessage("Select the next participant to respond."), []Message{NewSystemMessage(prompt)}, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("selector agent call failed: %w", err)
+	}
+
+	if len(response) == 0 {
+		return "", fmt.Errorf("selector agent returned no messages")
+	}
+
+	var selectedName string
+	lastMsg := response[len(response)-1]
+	if lastMsg.OfAssistant != nil && lastMsg.OfAssistant.Content.OfString.Value != "" {
+		selectedName = strings.TrimSpace(lastMsg.OfAssistant.Content.OfString.Value)
+	} else {
+		return "", fmt.Errorf("selector agent returned invalid response")
+	}
+
+	rec := NewExecutionRecorder(t.Recorder)
+	rec.SelectorAgentResponse(ctx, t.FullName(), selectorAgent.Name, selectedName, buildParticipants(t.Members))
+	return selectedName, nil
+}
+
+// selectorCache holds selector decisions for one team execution, keyed by a hash of the rendered prompt
+type selectorCache map[string]string
+
+func selectorCacheKey(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return hex.EncodeToString(sum[:])
+}
+
 func (t *Team) executeSelector(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
 	messages := append([]Message{}, history...)
 	var newMessages []Message
 
-	promptTemplate := defaultSelectorPrompt
-	if t.Selector != nil && t.Selector.SelectorPrompt != "" {
-		promptTemplate = t.Selector.SelectorPrompt
-	}
-
-	tmpl, err := template.New("selector").Parse(promptTemplate)
+	tmpl, err := t.parseSelectorTemplate()
 	if err != nil {
 		return newMessages, err
 	}
 
-	participantsList := buildParticipants(t.Members)
-	rolesList := buildRoles(t.Members)
 	previousMember := ""
 
+	// The cache lives for this execution only, so routing is never reused across queries
+	var cache selectorCache
+	if t.Selector != nil && t.Selector.CacheSelections {
+		cache = selectorCache{}
+	}
+
 	for turn := 0; ; turn++ {
 		turnTracker := NewExecutionRecorder(t.Recorder)
 		turnTracker.TeamTurn(ctx, "Start", t.FullName(), t.Strategy, turn)
 
-		nextMember, memberIndex, err := t.selectMember(ctx, messages, tmpl, participantsList, rolesList, previousMember)
+		nextMember, memberIndex, err := t.selectMember(ctx, messages, tmpl, previousMember, cache)
 		if err != nil {
 			return newMessages, err
 		}
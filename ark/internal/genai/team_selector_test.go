@@ -0,0 +1,121 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+func TestRenderSelectorPromptHistoryLimit(t *testing.T) {
+	team := newTestTeam("selector", &mockRecorder{}, &fakeTeamMember{name: "researcher"}, &fakeTeamMember{name: "writer"})
+	messages := []Message{
+		NewUserMessage("first question"),
+		NewAssistantMessage("first answer"),
+		NewUserMessage("second question"),
+		NewAssistantMessage("second answer"),
+	}
+
+	prompt, err := team.RenderSelectorPrompt(messages)
+	require.NoError(t, err)
+	assert.Contains(t, prompt, "first question")
+	assert.Contains(t, prompt, "researcher: researcher description, writer: writer description")
+
+	team.Selector = &arkv1alpha1.TeamSelectorSpec{MaxHistoryMessages: 2}
+	prompt, err = team.RenderSelectorPrompt(messages)
+	require.NoError(t, err)
+	assert.NotContains(t, prompt, "first question")
+	assert.NotContains(t, prompt, "first answer")
+	assert.Contains(t, prompt, "second question")
+	assert.Contains(t, prompt, "second answer")
+}
+
+func TestRenderSelectorPromptCustomTemplate(t *testing.T) {
+	team := newTestTeam("selector", &mockRecorder{}, &fakeTeamMember{name: "researcher"}, &fakeTeamMember{name: "writer"})
+	team.Selector = &arkv1alpha1.TeamSelectorSpec{SelectorPrompt: "Pick one of {{.Participants}}"}
+
+	prompt, err := team.RenderSelectorPrompt(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Pick one of researcher, writer", prompt)
+}
+
+func TestSelectMemberColdStart(t *testing.T) {
+	tests := []struct {
+		name          string
+		selector      *arkv1alpha1.TeamSelectorSpec
+		expected      string
+		expectedIndex int
+	}{
+		{name: "first member by default", expected: "researcher", expectedIndex: 0},
+		{name: "configured default member", selector: &arkv1alpha1.TeamSelectorSpec{DefaultMember: "writer"}, expected: "writer", expectedIndex: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &mockRecorder{}
+			team := newTestTeam("selector", recorder, &fakeTeamMember{name: "researcher"}, &fakeTeamMember{name: "writer"})
+			team.Selector = tt.selector
+
+			tmpl, err := team.parseSelectorTemplate()
+			require.NoError(t, err)
+
+			member, index, err := team.selectMember(context.Background(), nil, tmpl, "", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, member.GetName())
+			assert.Equal(t, tt.expectedIndex, index)
+
+			event, ok := recorder.eventFor("ParticipantSelected").(ExecutionEvent)
+			require.True(t, ok)
+			assert.Equal(t, "cold_start", event.Metadata["selection_reason"])
+		})
+	}
+}
+
+func TestSelectMemberReusesCachedSelection(t *testing.T) {
+	recorder := &mockRecorder{}
+	team := newTestTeam("selector", recorder, &fakeTeamMember{name: "researcher"}, &fakeTeamMember{name: "writer"})
+	// No selector agent is configured, so any call to it fails
+	team.Selector = &arkv1alpha1.TeamSelectorSpec{CacheSelections: true}
+
+	tmpl, err := team.parseSelectorTemplate()
+	require.NoError(t, err)
+	messages := []Message{NewUserMessage("write a summary")}
+	prompt, err := team.RenderSelectorPrompt(messages)
+	require.NoError(t, err)
+
+	cache := selectorCache{selectorCacheKey(prompt): "writer"}
+	member, index, err := team.selectMember(context.Background(), messages, tmpl, "", cache)
+	require.NoError(t, err)
+	assert.Equal(t, "writer", member.GetName())
+	assert.Equal(t, 1, index)
+
+	event, ok := recorder.eventFor("ParticipantSelected").(ExecutionEvent)
+	require.True(t, ok)
+	assert.Equal(t, "cached_selection", event.Metadata["selection_reason"])
+
+	_, _, err = team.selectMember(context.Background(), append(messages, NewUserMessage("and a title")), tmpl, "", cache)
+	require.EqualError(t, err, "selector agent must be specified")
+}
+
+func TestBuildRolesIncludesAgentSkills(t *testing.T) {
+	skilled := &Agent{
+		Name:        "aws-agent",
+		Description: "AWS operations",
+		Annotations: map[string]string{
+			annotations.A2AServerSkills: `[{"id":"ec2","name":"describe_ec2","description":"List EC2 instances","tags":[]},{"id":"s3","name":"list_buckets","tags":[]}]`,
+		},
+	}
+	malformed := &Agent{
+		Name:        "broken-agent",
+		Annotations: map[string]string{annotations.A2AServerSkills: "not json"},
+	}
+
+	roles := buildRoles([]TeamMember{skilled, malformed, &fakeTeamMember{name: "writer"}})
+	assert.Equal(t, "aws-agent: AWS operations (skills: describe_ec2 - List EC2 instances; list_buckets), broken-agent, writer: writer description", roles)
+}
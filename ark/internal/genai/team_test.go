@@ -0,0 +1,259 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type fakeTeamMember struct {
+	name   string
+	err    error
+	block  bool
+	silent bool
+	calls  int
+	// reply replaces the default response text when set
+	reply string
+	// usage, when set, is reported to emitter as if the member had called a model
+	usage   TokenUsage
+	emitter EventEmitter
+}
+
+func (m *fakeTeamMember) Execute(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
+	m.calls++
+	if m.emitter != nil {
+		m.emitter.EmitEvent(ctx, "Normal", "LLMCallComplete", OperationEvent{TokenUsage: m.usage})
+	}
+	if m.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.silent {
+		return nil, nil
+	}
+	if m.reply != "" {
+		return []Message{NewAssistantMessage(m.reply)}, nil
+	}
+	return []Message{NewAssistantMessage("response from " + m.name)}, nil
+}
+
+func (m *fakeTeamMember) GetName() string        { return m.name }
+func (m *fakeTeamMember) GetType() string        { return "agent" }
+func (m *fakeTeamMember) GetDescription() string { return m.name + " description" }
+
+func newTestTeam(strategy string, recorder EventEmitter, members ...*fakeTeamMember) *Team {
+	teamMembers := make([]TeamMember, 0, len(members))
+	for _, member := range members {
+		teamMembers = append(teamMembers, member)
+	}
+	return &Team{
+		Name:      "test-team",
+		Namespace: "default",
+		Members:   teamMembers,
+		Strategy:  strategy,
+		Recorder:  recorder,
+	}
+}
+
+func TestTeamTimeoutReturnsAccumulatedMessages(t *testing.T) {
+	recorder := &mockRecorder{}
+	first := &fakeTeamMember{name: "first"}
+	slow := &fakeTeamMember{name: "slow", block: true}
+	team := newTestTeam("sequential", recorder, first, slow)
+	team.Timeout = 50 * time.Millisecond
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, 1, slow.calls)
+
+	event, ok := recorder.eventFor("TeamTimeout").(BaseEvent)
+	require.True(t, ok)
+	assert.Equal(t, "50ms", event.Metadata["timeout"])
+	assert.Equal(t, "1", event.Metadata["messages"])
+}
+
+func TestTeamTimeoutDoesNotMaskParentCancellation(t *testing.T) {
+	recorder := &mockRecorder{}
+	team := newTestTeam("sequential", recorder, &fakeTeamMember{name: "slow", block: true})
+	team.Timeout = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := team.Execute(ctx, NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+	assert.Nil(t, recorder.eventFor("TeamTimeout"))
+}
+
+func TestTeamCancellationInterruptsA2AMember(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// The agent works until the caller goes away, which the server only notices once the body is read
+		_, _ = io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	recorder := &mockRecorder{}
+	team := &Team{
+		Name:      "test-team",
+		Namespace: "default",
+		Strategy:  "sequential",
+		Members:   []TeamMember{newTestA2AAgent(t, recorder, srv.URL)},
+		Recorder:  recorder,
+		Retries:   2,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := team.Execute(ctx, NewUserMessage("hi"), nil, nil, nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Nil(t, recorder.eventFor("TeamRetry"))
+}
+
+func TestTeamNoMembersExecuted(t *testing.T) {
+	member := &fakeTeamMember{name: "idle"}
+	team := newTestTeam("round-robin", &mockRecorder{}, member)
+	maxTurns := 0
+	team.MaxTurns = &maxTurns
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+	assert.True(t, IsNoMembersExecuted(err))
+	assert.Contains(t, err.Error(), "no members ran")
+	assert.Empty(t, messages)
+	assert.Equal(t, 0, member.calls)
+}
+
+func TestTeamSilentMembersAreNotNoMembersExecuted(t *testing.T) {
+	member := &fakeTeamMember{name: "silent", silent: true}
+	team := newTestTeam("sequential", &mockRecorder{}, member)
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+	assert.Equal(t, 1, member.calls)
+}
+
+func TestTeamExecuteStream(t *testing.T) {
+	team := newTestTeam("sequential", &mockRecorder{}, &fakeTeamMember{name: "first"}, &fakeTeamMember{name: "second"})
+
+	var results []MemberResult
+	for result := range team.ExecuteStream(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil) {
+		results = append(results, result)
+	}
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "first", results[0].Member)
+	assert.Equal(t, 0, results[0].Turn)
+	assert.Len(t, results[0].Messages, 1)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "second", results[1].Member)
+	assert.Equal(t, 1, results[1].Turn)
+}
+
+func TestTeamExecuteStreamReportsFailure(t *testing.T) {
+	failure := errors.New("boom")
+	team := newTestTeam("sequential", &mockRecorder{}, &fakeTeamMember{name: "first"}, &fakeTeamMember{name: "broken", err: failure}, &fakeTeamMember{name: "never"})
+
+	var results []MemberResult
+	for result := range team.ExecuteStream(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil) {
+		results = append(results, result)
+	}
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "first", results[0].Member)
+	assert.Equal(t, "broken", results[1].Member)
+	assert.ErrorIs(t, results[1].Err, failure)
+	assert.Empty(t, results[2].Member)
+	assert.ErrorIs(t, results[2].Err, failure)
+}
+
+func TestTeamParallelCollectsMessagesInMemberOrder(t *testing.T) {
+	team := newTestTeam("parallel", &mockRecorder{}, &fakeTeamMember{name: "first"}, &fakeTeamMember{name: "second"}, &fakeTeamMember{name: "third"})
+
+	var results []MemberResult
+	for result := range team.ExecuteStream(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil) {
+		results = append(results, result)
+	}
+	require.Len(t, results, 3)
+	for _, result := range results {
+		assert.Equal(t, 0, result.Turn)
+		assert.NoError(t, result.Err)
+	}
+
+	messages, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	assert.Equal(t, "response from first", messages[0].OfAssistant.Content.OfString.Value)
+	assert.Equal(t, "response from third", messages[2].OfAssistant.Content.OfString.Value)
+}
+
+func TestTeamParallelFirstErrorCancelsRunningMembers(t *testing.T) {
+	recorder := &mockRecorder{}
+	failure := errors.New("boom")
+	blocked := &fakeTeamMember{name: "blocked", block: true}
+	team := newTestTeam("parallel", recorder, blocked, &fakeTeamMember{name: "broken", err: failure})
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.ErrorIs(t, err, failure)
+	assert.Contains(t, err.Error(), "agent broken failed")
+	assert.Equal(t, 1, blocked.calls)
+
+	event, ok := recorder.eventFor("TeamMemberFailed").(BaseEvent)
+	require.True(t, ok)
+	assert.Equal(t, "broken", event.Name)
+}
+
+func TestTeamMemberSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previous)
+
+	collector := NewTokenUsageCollector(&mockRecorder{})
+	first := &fakeTeamMember{name: "first", emitter: collector, usage: TokenUsage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}}
+	broken := &fakeTeamMember{name: "broken", err: errors.New("boom")}
+	team := newTestTeam("sequential", collector, first, broken)
+
+	_, err := team.Execute(context.Background(), NewUserMessage("hello"), nil, NewNoopMemory(), nil)
+	require.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "team.member", spans[0].Name)
+	assert.Equal(t, codes.Ok, spans[0].Status.Code)
+	assert.Contains(t, spans[0].Attributes, attribute.String("agent.name", "first"))
+	assert.Contains(t, spans[0].Attributes, attribute.String("team.member.type", "agent"))
+	assert.Contains(t, spans[0].Attributes, attribute.Int("team.member.turn", 0))
+	assert.Contains(t, spans[0].Attributes, attribute.Int64("tokens.total", 7))
+
+	assert.Contains(t, spans[1].Attributes, attribute.Int("team.member.turn", 1))
+	assert.Contains(t, spans[1].Attributes, attribute.Int64("tokens.total", 0))
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+}
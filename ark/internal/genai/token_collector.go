@@ -5,6 +5,8 @@ package genai
 import (
 	"context"
 	"sync"
+
+	"k8s.io/client-go/tools/record"
 )
 
 type TokenUsageCollector struct {
@@ -23,6 +25,12 @@ func NewTokenUsageCollector(recorder EventEmitter) *TokenUsageCollector {
 func (c *TokenUsageCollector) EmitEvent(ctx context.Context, eventType, reason string, data EventData) {
 	c.recorder.EmitEvent(ctx, eventType, reason, data)
 
+	// A team's usage is the sum of what its members already reported, including A2A agents,
+	// so counting it again would double the query's totals
+	if reason == "TeamExecutionComplete" {
+		return
+	}
+
 	if opEvent, ok := data.(OperationEvent); ok && opEvent.TokenUsage.TotalTokens > 0 {
 		c.mu.Lock()
 		c.tokenUsages = append(c.tokenUsages, opEvent.TokenUsage)
@@ -30,6 +38,11 @@ func (c *TokenUsageCollector) EmitEvent(ctx context.Context, eventType, reason s
 	}
 }
 
+// EventRecorder returns the Kubernetes event recorder of the wrapped emitter, if it has one
+func (c *TokenUsageCollector) EventRecorder() record.EventRecorder {
+	return kubernetesEventRecorder(c.recorder)
+}
+
 func (c *TokenUsageCollector) GetTokenSummary() TokenUsage {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
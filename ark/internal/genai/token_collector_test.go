@@ -4,6 +4,7 @@ package genai
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,11 +12,28 @@ import (
 )
 
 type mockRecorder struct {
-	events []EventData
+	mu      sync.Mutex
+	events  []EventData
+	reasons []string
 }
 
 func (m *mockRecorder) EmitEvent(ctx context.Context, eventType, reason string, data EventData) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.events = append(m.events, data)
+	m.reasons = append(m.reasons, reason)
+}
+
+// eventFor returns the first recorded event with the given reason
+func (m *mockRecorder) eventFor(reason string) EventData {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, r := range m.reasons {
+		if r == reason {
+			return m.events[i]
+		}
+	}
+	return nil
 }
 
 func TestTokenUsageCollector(t *testing.T) {
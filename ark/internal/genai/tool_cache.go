@@ -0,0 +1,50 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+const toolResultCacheKey contextKey = "toolResultCache"
+
+// ToolResultCache holds results of cacheable tool calls for the duration of a single query
+type ToolResultCache struct {
+	mu      sync.Mutex
+	results map[string]string
+}
+
+// WithToolResultCache attaches a new query-scoped tool result cache to the context
+func WithToolResultCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, toolResultCacheKey, &ToolResultCache{results: make(map[string]string)})
+}
+
+func getToolResultCache(ctx context.Context) *ToolResultCache {
+	cache, _ := ctx.Value(toolResultCacheKey).(*ToolResultCache)
+	return cache
+}
+
+// toolCacheKey builds a cache key from the tool identity and its arguments.
+// Arguments are re-marshaled so that key order and whitespace do not affect the key.
+func toolCacheKey(server, toolName string, arguments map[string]any) (string, error) {
+	canonical, err := json.Marshal(arguments)
+	if err != nil {
+		return "", err
+	}
+	return server + "/" + toolName + ":" + string(canonical), nil
+}
+
+func (c *ToolResultCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result, ok := c.results[key]
+	return result, ok
+}
+
+func (c *ToolResultCache) put(key, result string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.results[key] = result
+}
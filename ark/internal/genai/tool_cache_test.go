@@ -0,0 +1,102 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type geocodeArgs struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+func newInMemoryMCPClient(t *testing.T, calls *atomic.Int32) *MCPClient {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "geo", Version: "v1"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "geocode"}, func(_ context.Context, _ *mcp.CallToolRequest, args geocodeArgs) (*mcp.CallToolResult, any, error) {
+		n := calls.Add(1)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("%s,%s#%d", args.City, args.Country, n)}},
+		}, nil, nil
+	})
+	return connectInMemoryMCPServer(t, server)
+}
+
+func connectInMemoryMCPServer(t *testing.T, server *mcp.Server) *MCPClient {
+	t.Helper()
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "ark", Version: "v1"}, newMCPClientOptions())
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return &MCPClient{baseURL: "memory://geo", client: clientSession}
+}
+
+func geocodeCall(arguments string) ToolCall {
+	return ToolCall{
+		ID: "call-1",
+		Function: openai.ChatCompletionMessageToolCallFunction{
+			Name:      "geocode",
+			Arguments: arguments,
+		},
+	}
+}
+
+func TestMCPExecutorResultCache(t *testing.T) {
+	var calls atomic.Int32
+	executor := &MCPExecutor{MCPClient: newInMemoryMCPClient(t, &calls), ToolName: "geocode", CacheResults: true}
+	ctx := WithToolResultCache(context.Background())
+
+	first, err := executor.Execute(ctx, geocodeCall(`{"city":"Paris","country":"FR"}`), nil)
+	require.NoError(t, err)
+	second, err := executor.Execute(ctx, geocodeCall(`{ "country": "FR", "city": "Paris" }`), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "Paris,FR#1", first.Content)
+	assert.Equal(t, first.Content, second.Content)
+
+	third, err := executor.Execute(ctx, geocodeCall(`{"city":"Berlin","country":"DE"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, "Berlin,DE#2", third.Content)
+}
+
+func TestMCPExecutorResultCacheNotApplied(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheResults bool
+		ctx          context.Context
+	}{
+		{name: "caching disabled for tool", cacheResults: false, ctx: WithToolResultCache(context.Background())},
+		{name: "no cache in context", cacheResults: true, ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			executor := &MCPExecutor{MCPClient: newInMemoryMCPClient(t, &calls), ToolName: "geocode", CacheResults: tt.cacheResults}
+
+			for range 2 {
+				_, err := executor.Execute(tt.ctx, geocodeCall(`{"city":"Paris","country":"FR"}`), nil)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
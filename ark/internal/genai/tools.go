@@ -14,8 +14,6 @@ import (
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/shared"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -119,7 +117,7 @@ func (h *HTTPExecutor) Execute(ctx context.Context, call ToolCall, recorder Even
 
 	// Add headers
 	for _, header := range httpSpec.Headers {
-		value, err := h.resolveHeaderValue(ctx, header.Value, tool.Namespace)
+		value, err := ResolveHeaderValue(ctx, h.K8sClient, header, tool.Namespace)
 		if err != nil {
 			return ToolResult{
 				ID:    call.ID,
@@ -523,38 +521,3 @@ func getToolParameters(toolCRD *arkv1alpha1.Tool) map[string]any {
 func CreateHTTPTool(toolCRD *arkv1alpha1.Tool) ToolDefinition {
 	return CreateToolFromCRD(toolCRD)
 }
-
-func (h *HTTPExecutor) resolveHeaderValue(ctx context.Context, headerValue arkv1alpha1.HeaderValue, namespace string) (string, error) {
-	// If static value is provided, use it directly
-	if headerValue.Value != "" {
-		return headerValue.Value, nil
-	}
-
-	// If secret reference is provided, resolve it
-	if headerValue.ValueFrom != nil && headerValue.ValueFrom.SecretKeyRef != nil {
-		secretRef := headerValue.ValueFrom.SecretKeyRef
-		secret := &corev1.Secret{}
-
-		namespacedName := types.NamespacedName{
-			Name:      secretRef.Name,
-			Namespace: namespace,
-		}
-
-		if err := h.K8sClient.Get(ctx, namespacedName, secret); err != nil {
-			return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, secretRef.Name, err)
-		}
-
-		if secret.Data == nil {
-			return "", fmt.Errorf("secret %s/%s has no data", namespace, secretRef.Name)
-		}
-
-		value, exists := secret.Data[secretRef.Key]
-		if !exists {
-			return "", fmt.Errorf("key %s not found in secret %s/%s", secretRef.Key, namespace, secretRef.Name)
-		}
-
-		return string(value), nil
-	}
-
-	return "", fmt.Errorf("header value must specify either value or valueFrom.secretKeyRef")
-}
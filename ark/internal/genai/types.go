@@ -3,6 +3,7 @@ package genai
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/openai/openai-go"
 )
@@ -43,6 +44,31 @@ type ToolResult struct {
 	Name    string `json:"name"`
 	Content string `json:"content,omitempty"`
 	Error   string `json:"error,omitempty"`
+	// Partial is set when Content holds only what was received before the call failed, such as on a timeout
+	Partial bool `json:"partial,omitempty"`
+	// Attachments holds non-text content such as images; Content describes each one in place
+	Attachments []ToolResultAttachment `json:"attachments,omitempty"`
+}
+
+// ToolResultAttachment is non-text content returned by a tool. Data is base64 encoded and is empty
+// for resources that are only referenced by URI.
+type ToolResultAttachment struct {
+	Type     string `json:"type"`
+	MimeType string `json:"mimeType,omitempty"`
+	URI      string `json:"uri,omitempty"`
+	Data     string `json:"data,omitempty"`
+}
+
+// MessageContent is the content sent back to the model for this result. Failed calls without
+// content report their error, since the model expects a tool message for every tool call.
+func (r ToolResult) MessageContent() string {
+	if r.Content == "" && r.Error != "" {
+		return "Error: " + r.Error
+	}
+	if r.Partial {
+		return r.Content + "\n\n[Partial result, the tool call did not complete: " + r.Error + "]"
+	}
+	return r.Content
 }
 
 type ToolExecutor interface {
@@ -55,6 +81,21 @@ func (e *TerminateTeam) Error() string {
 	return "TerminateTeam"
 }
 
+// NoMembersExecuted is returned when a team run finishes without executing any member,
+// as opposed to members that ran but produced no messages
+type NoMembersExecuted struct {
+	Team string
+}
+
+func (e *NoMembersExecuted) Error() string {
+	return fmt.Sprintf("team %s produced no output because no members ran", e.Team)
+}
+
+func IsNoMembersExecuted(err error) bool {
+	var noMembersErr *NoMembersExecuted
+	return errors.As(err, &noMembersErr)
+}
+
 func IsTerminateTeam(err error) bool {
 	if err == nil {
 		return false
@@ -0,0 +1,22 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import "net/http"
+
+// userAgent is sent on outgoing A2A and MCP requests. A User-Agent set in the
+// server's configured headers takes precedence.
+var userAgent = "ark/dev"
+
+// SetUserAgent sets the User-Agent of outgoing A2A and MCP requests. An empty override uses ark/<version>.
+func SetUserAgent(override, version string) {
+	if override != "" {
+		userAgent = override
+		return
+	}
+	userAgent = "ark/" + version
+}
+
+func setUserAgent(header http.Header) {
+	header.Set("User-Agent", userAgent)
+}
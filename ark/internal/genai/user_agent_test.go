@@ -0,0 +1,42 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgent(t *testing.T) {
+	previous := userAgent
+	defer func() { userAgent = previous }()
+
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(`{"name":"weather-agent","url":"http://example"}`))
+	}))
+	defer server.Close()
+
+	SetUserAgent("", "1.2.3")
+	_, err := DiscoverA2AAgents(context.Background(), nil, server.URL, nil, "default")
+	require.NoError(t, err)
+
+	transport := &headerTransport{headers: map[string]string{"User-Agent": "custom-client"}, base: http.DefaultTransport}
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	SetUserAgent("ark-staging", "1.2.3")
+	_, err = DiscoverA2AAgents(context.Background(), nil, server.URL, nil, "default")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ark/1.2.3", "custom-client", "ark-staging"}, received)
+}
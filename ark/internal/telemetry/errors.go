@@ -0,0 +1,47 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// Error types recorded as the error.type span attribute and used to prefix span status descriptions
+const (
+	ErrorTypeCancelled      = "cancelled"
+	ErrorTypeTimeout        = "timeout"
+	ErrorTypeConnection     = "connection"
+	ErrorTypeInvalidRequest = "invalid_request"
+	ErrorTypeServer         = "server_error"
+	ErrorTypeUnknown        = "error"
+)
+
+// ErrorTyper is implemented by errors that know their own error type, such as A2A JSON-RPC errors
+type ErrorTyper interface {
+	ErrorType() string
+}
+
+// ClassifyError maps an error to one of the ErrorType values, looking through wrapped errors
+func ClassifyError(err error) string {
+	var typed ErrorTyper
+	if errors.As(err, &typed) {
+		return typed.ErrorType()
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrorTypeCancelled
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorTypeTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorTypeTimeout
+	}
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) {
+		return ErrorTypeConnection
+	}
+	return ErrorTypeUnknown
+}
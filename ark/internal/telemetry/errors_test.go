@@ -0,0 +1,58 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type typedError struct{}
+
+func (typedError) Error() string     { return "rpc failed" }
+func (typedError) ErrorType() string { return ErrorTypeServer }
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "cancelled", err: fmt.Errorf("call aborted: %w", context.Canceled), expected: ErrorTypeCancelled},
+		{name: "deadline", err: fmt.Errorf("call aborted: %w", context.DeadlineExceeded), expected: ErrorTypeTimeout},
+		{name: "network timeout", err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}, expected: ErrorTypeTimeout},
+		{name: "connection", err: fmt.Errorf("dial: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), expected: ErrorTypeConnection},
+		{name: "typed", err: fmt.Errorf("wrapped: %w", typedError{}), expected: ErrorTypeServer},
+		{name: "unknown", err: errors.New("boom"), expected: ErrorTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.err))
+		})
+	}
+}
+
+func TestRecordErrorSetsErrorType(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	_, span := tp.Tracer(TracerName).Start(context.Background(), "query.execute")
+	RecordError(span, fmt.Errorf("agent failed: %w", context.DeadlineExceeded))
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "timeout: agent failed: context deadline exceeded", spans[0].Status.Description)
+	assert.Contains(t, spans[0].Attributes, attribute.String("error.type", ErrorTypeTimeout))
+}
@@ -0,0 +1,46 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// LogSpanExporter writes each finished span with its attributes as a structured log line,
+// for deployments without an OTLP collector
+type LogSpanExporter struct {
+	logger logr.Logger
+}
+
+func NewLogSpanExporter(logger logr.Logger) *LogSpanExporter {
+	return &LogSpanExporter{logger: logger}
+}
+
+func (e *LogSpanExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, span := range spans {
+		keysAndValues := []any{
+			"span", span.Name(),
+			"traceId", span.SpanContext().TraceID().String(),
+			"spanId", span.SpanContext().SpanID().String(),
+			"durationMs", span.EndTime().Sub(span.StartTime()).Milliseconds(),
+			"status", span.Status().Code.String(),
+		}
+		if span.Parent().IsValid() {
+			keysAndValues = append(keysAndValues, "parentSpanId", span.Parent().SpanID().String())
+		}
+		if span.Status().Code == codes.Error && span.Status().Description != "" {
+			keysAndValues = append(keysAndValues, "statusDescription", span.Status().Description)
+		}
+		for _, attr := range span.Attributes() {
+			keysAndValues = append(keysAndValues, string(attr.Key), attr.Value.AsInterface())
+		}
+		e.logger.Info("span finished", keysAndValues...)
+	}
+	return nil
+}
+
+func (e *LogSpanExporter) Shutdown(context.Context) error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLogSpanExporter(t *testing.T) {
+	var lines []string
+	logger := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewLogSpanExporter(logger)))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	_, span := tp.Tracer(TracerName).Start(context.Background(), "tool.execute")
+	span.SetAttributes(
+		attribute.String("tool.name", "get-weather"),
+		attribute.Int64("tokens.total", 42),
+	)
+	RecordError(span, errors.New("connection refused"))
+	span.End()
+
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"msg"="span finished"`)
+	assert.Contains(t, lines[0], `"span"="tool.execute"`)
+	assert.Contains(t, lines[0], `"status"="Error"`)
+	assert.Contains(t, lines[0], `"statusDescription"="error: connection refused"`)
+	assert.Contains(t, lines[0], `"tool.name"="get-weather"`)
+	assert.Contains(t, lines[0], `"tokens.total"=42`)
+}
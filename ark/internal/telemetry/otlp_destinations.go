@@ -0,0 +1,64 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+)
+
+// otlpDestinationEnv returns the name of an ARK_OTLP_<NAME>_<SETTING> variable for a named OTLP destination
+func otlpDestinationEnv(name, setting string) string {
+	name = strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+	return "ARK_OTLP_" + name + "_" + setting
+}
+
+// newNamedOTLPExporter creates an OTLP exporter for an additional destination, such as otlp/jaeger,
+// so spans can be sent to several backends at once. The destination is configured with
+// ARK_OTLP_<NAME>_ENDPOINT, the full traces URL, and optional ARK_OTLP_<NAME>_HEADERS. Headers of the
+// default OTLP endpoint are not sent to it.
+func newNamedOTLPExporter(name string) (*otlptrace.Exporter, error) {
+	endpointEnv := otlpDestinationEnv(name, "ENDPOINT")
+	endpoint := os.Getenv(endpointEnv)
+	if endpoint == "" {
+		return nil, fmt.Errorf("%s not set", endpointEnv)
+	}
+	headers, err := parseOTLPHeaders(os.Getenv(otlpDestinationEnv(name, "HEADERS")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", otlpDestinationEnv(name, "HEADERS"), err)
+	}
+
+	return otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(endpoint),
+		otlptracehttp.WithHeaders(headers),
+	)
+}
+
+// parseOTLPHeaders reads headers in the OTEL_EXPORTER_OTLP_HEADERS format: comma-separated,
+// URL-encoded key=value pairs
+func parseOTLPHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, val, found := strings.Cut(pair, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("header %q is not a key=value pair", pair)
+		}
+		decodedKey, err := url.PathUnescape(strings.TrimSpace(key))
+		if err != nil {
+			return nil, err
+		}
+		decodedVal, err := url.PathUnescape(strings.TrimSpace(val))
+		if err != nil {
+			return nil, err
+		}
+		headers[decodedKey] = decodedVal
+	}
+	return headers, nil
+}
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOTLPHeaders(t *testing.T) {
+	headers, err := parseOTLPHeaders("Authorization=Basic%20dXNlcjpwYXNz, x-tenant = blue,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Authorization": "Basic dXNlcjpwYXNz", "x-tenant": "blue"}, headers)
+
+	headers, err = parseOTLPHeaders("")
+	require.NoError(t, err)
+	assert.Empty(t, headers)
+
+	_, err = parseOTLPHeaders("Authorization")
+	assert.Error(t, err)
+}
+
+func TestNewNamedOTLPExporter(t *testing.T) {
+	assert.Equal(t, "ARK_OTLP_JAEGER_DEV_ENDPOINT", otlpDestinationEnv("jaeger-dev", "ENDPOINT"))
+
+	_, err := newNamedOTLPExporter("jaeger-dev")
+	assert.EqualError(t, err, "ARK_OTLP_JAEGER_DEV_ENDPOINT not set")
+
+	t.Setenv("ARK_OTLP_JAEGER_DEV_ENDPOINT", "http://jaeger:4318/v1/traces")
+	t.Setenv("ARK_OTLP_JAEGER_DEV_HEADERS", "x-tenant=blue")
+	exporter, err := newNamedOTLPExporter("jaeger-dev")
+	require.NoError(t, err)
+	assert.NotNil(t, exporter)
+}
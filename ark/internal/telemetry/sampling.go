@@ -0,0 +1,102 @@
+package telemetry
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SamplingConfig controls head-based sampling of controller spans
+type SamplingConfig struct {
+	// Rate is the probability of sampling a new trace, from 0 to 1. Child spans follow their parent.
+	Rate float64
+	// SampleErrors exports spans that end with an error status even when their trace was not sampled
+	SampleErrors bool
+}
+
+// samplingConfigFromEnv reads ARK_TRACES_SAMPLE_RATE and ARK_TRACES_SAMPLE_ERRORS (default true).
+// It returns nil when no rate is set, leaving sampling to the SDK defaults and OTEL_TRACES_SAMPLER.
+func samplingConfigFromEnv() (*SamplingConfig, error) {
+	rateStr := os.Getenv("ARK_TRACES_SAMPLE_RATE")
+	if rateStr == "" {
+		return nil, nil
+	}
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return nil, fmt.Errorf("ARK_TRACES_SAMPLE_RATE must be a number between 0 and 1, got %q", rateStr)
+	}
+
+	config := &SamplingConfig{Rate: rate, SampleErrors: true}
+	if errorsStr := os.Getenv("ARK_TRACES_SAMPLE_ERRORS"); errorsStr != "" {
+		sampleErrors, err := strconv.ParseBool(errorsStr)
+		if err != nil {
+			return nil, fmt.Errorf("ARK_TRACES_SAMPLE_ERRORS must be a boolean, got %q", errorsStr)
+		}
+		config.SampleErrors = sampleErrors
+	}
+	return config, nil
+}
+
+func (c SamplingConfig) Sampler() sdktrace.Sampler {
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.Rate))
+	if c.SampleErrors {
+		return recordingSampler{base: sampler}
+	}
+	return sampler
+}
+
+// SpanProcessor batches spans to the exporter, including unsampled spans that ended with an error
+// when SampleErrors is set
+func (c SamplingConfig) SpanProcessor(exporter sdktrace.SpanExporter) sdktrace.SpanProcessor {
+	processor := sdktrace.NewBatchSpanProcessor(exporter)
+	if c.SampleErrors {
+		return errorSpanProcessor{SpanProcessor: processor}
+	}
+	return processor
+}
+
+// recordingSampler keeps spans dropped by the base sampler recording so their status is known when they end
+type recordingSampler struct {
+	base sdktrace.Sampler
+}
+
+func (s recordingSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.base.ShouldSample(p)
+	if result.Decision == sdktrace.Drop {
+		result.Decision = sdktrace.RecordOnly
+	}
+	return result
+}
+
+func (s recordingSampler) Description() string {
+	return fmt.Sprintf("RecordingSampler{%s}", s.base.Description())
+}
+
+// errorSpanProcessor forwards sampled spans and unsampled spans with an error status, dropping the rest
+type errorSpanProcessor struct {
+	sdktrace.SpanProcessor
+}
+
+func (p errorSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		if s.Status().Code != codes.Error {
+			return
+		}
+		s = sampledSpan{ReadOnlySpan: s}
+	}
+	p.SpanProcessor.OnEnd(s)
+}
+
+// sampledSpan marks a recorded span as sampled so batch processors export it
+type sampledSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
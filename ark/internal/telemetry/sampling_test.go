@@ -0,0 +1,69 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSamplingConfigFromEnv(t *testing.T) {
+	config, err := samplingConfigFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	t.Setenv("ARK_TRACES_SAMPLE_RATE", "0.25")
+	config, err = samplingConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, &SamplingConfig{Rate: 0.25, SampleErrors: true}, config)
+
+	t.Setenv("ARK_TRACES_SAMPLE_ERRORS", "false")
+	config, err = samplingConfigFromEnv()
+	require.NoError(t, err)
+	assert.False(t, config.SampleErrors)
+
+	t.Setenv("ARK_TRACES_SAMPLE_RATE", "2")
+	_, err = samplingConfigFromEnv()
+	assert.Error(t, err)
+}
+
+func TestSamplingExportsErrorSpans(t *testing.T) {
+	tests := []struct {
+		name         string
+		sampleErrors bool
+		expected     []string
+	}{
+		{name: "errors sampled", sampleErrors: true, expected: []string{"tool.execute"}},
+		{name: "errors not sampled", sampleErrors: false, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := SamplingConfig{Rate: 0, SampleErrors: tt.sampleErrors}
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(
+				sdktrace.WithSampler(config.Sampler()),
+				sdktrace.WithSpanProcessor(config.SpanProcessor(exporter)),
+			)
+
+			tracer := tp.Tracer(TracerName)
+			ctx, parent := tracer.Start(context.Background(), "query.execute")
+			_, child := tracer.Start(ctx, "tool.execute")
+			RecordError(child, errors.New("tool failed"))
+			child.End()
+			parent.End()
+			require.NoError(t, tp.ForceFlush(context.Background()))
+
+			var names []string
+			for _, span := range exporter.GetSpans() {
+				names = append(names, span.Name)
+				assert.True(t, span.SpanContext.IsSampled())
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
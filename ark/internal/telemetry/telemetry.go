@@ -3,6 +3,7 @@ package telemetry
 import (
 	"context"
 	"os"
+	"strings"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
@@ -16,9 +17,9 @@ var log = logf.Log.WithName("telemetry")
 
 func Initialize() func() {
 	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
-	if endpoint == "" {
-		log.Info("OTEL_EXPORTER_OTLP_ENDPOINT not set, telemetry disabled")
-		return func() {}
+	tracesExporter := os.Getenv("OTEL_TRACES_EXPORTER")
+	if tracesExporter == "" {
+		tracesExporter = "otlp"
 	}
 
 	headers := os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")
@@ -27,23 +28,81 @@ func Initialize() func() {
 		serviceName = "ark-controller"
 	}
 
-	log.Info("initializing telemetry", "endpoint", endpoint, "service", serviceName, "headers", headers)
+	options := []trace.TracerProviderOption{
+		trace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	}
+	exporters := 0
 
-	// Auto-configure OTLP exporter from environment variables:
-	// OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_OTLP_HEADERS, OTEL_SERVICE_NAME
-	exporter, err := otlptracehttp.New(context.Background())
+	sampling, err := samplingConfigFromEnv()
 	if err != nil {
-		log.Error(err, "failed to create OTLP exporter")
+		log.Error(err, "ignoring invalid sampling configuration")
+	}
+	if sampling != nil {
+		log.Info("configuring trace sampling", "rate", sampling.Rate, "sampleErrors", sampling.SampleErrors)
+		options = append(options, trace.WithSampler(sampling.Sampler()))
+	}
+	if toolPayloadMaxBytes, err = toolPayloadMaxBytesFromEnv(); err != nil {
+		log.Error(err, "using default tool payload limit", "limit", toolPayloadMaxBytes)
+	}
+
+	addExporter := func(exporter trace.SpanExporter) {
+		if sampling != nil {
+			options = append(options, trace.WithSpanProcessor(sampling.SpanProcessor(exporter)))
+		} else {
+			options = append(options, trace.WithBatcher(exporter))
+		}
+		exporters++
+	}
+
+	// OTEL_TRACES_EXPORTER is a comma-separated list: otlp (default) sends spans to the OTLP endpoint,
+	// otlp/<name> to an additional OTLP destination, and console writes span attributes to the controller log
+	for _, name := range strings.Split(tracesExporter, ",") {
+		name = strings.TrimSpace(name)
+		if destination, ok := strings.CutPrefix(name, "otlp/"); ok {
+			exporter, err := newNamedOTLPExporter(destination)
+			if err != nil {
+				log.Error(err, "failed to create OTLP exporter", "destination", destination)
+				continue
+			}
+			log.Info("initializing telemetry", "destination", destination, "service", serviceName)
+			addExporter(exporter)
+			continue
+		}
+
+		switch name {
+		case "otlp":
+			if endpoint == "" {
+				log.Info("OTEL_EXPORTER_OTLP_ENDPOINT not set, OTLP export disabled")
+				continue
+			}
+			log.Info("initializing telemetry", "endpoint", endpoint, "service", serviceName, "headers", headers)
+
+			// Auto-configure OTLP exporter from environment variables:
+			// OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_OTLP_HEADERS, OTEL_SERVICE_NAME
+			exporter, err := otlptracehttp.New(context.Background())
+			if err != nil {
+				log.Error(err, "failed to create OTLP exporter")
+				continue
+			}
+			addExporter(exporter)
+		case "console":
+			log.Info("initializing telemetry log export", "service", serviceName)
+			addExporter(NewLogSpanExporter(log.WithName("spans")))
+		case "none", "":
+		default:
+			log.Info("ignoring unsupported traces exporter", "exporter", name)
+		}
+	}
+
+	if exporters == 0 {
+		log.Info("no traces exporter configured, telemetry disabled")
 		return func() {}
 	}
 
-	tp := trace.NewTracerProvider(
-		trace.WithBatcher(exporter),
-		trace.WithResource(resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceName(serviceName),
-		)),
-	)
+	tp := trace.NewTracerProvider(options...)
 
 	otel.SetTracerProvider(tp)
 
@@ -0,0 +1,46 @@
+package telemetry
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultToolPayloadMaxBytes bounds the tool input and output recorded on a span
+const DefaultToolPayloadMaxBytes = 32 * 1024
+
+// toolPayloadMaxBytes is the current limit. Zero or less records payloads in full.
+var toolPayloadMaxBytes = DefaultToolPayloadMaxBytes
+
+// toolPayloadMaxBytesFromEnv reads ARK_TRACES_TOOL_PAYLOAD_MAX_BYTES, returning the default when it is not set
+func toolPayloadMaxBytesFromEnv() (int, error) {
+	value := os.Getenv("ARK_TRACES_TOOL_PAYLOAD_MAX_BYTES")
+	if value == "" {
+		return DefaultToolPayloadMaxBytes, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return DefaultToolPayloadMaxBytes, fmt.Errorf("ARK_TRACES_TOOL_PAYLOAD_MAX_BYTES must be an integer, got %q", value)
+	}
+	return limit, nil
+}
+
+// toolPayloadAttributes returns the attribute for a tool input or output, cut off at the payload limit
+// with a marker, along with the payload's original length in bytes
+func toolPayloadAttributes(kind, payload string) []attribute.KeyValue {
+	value := payload
+	if toolPayloadMaxBytes > 0 && len(payload) > toolPayloadMaxBytes {
+		cut := toolPayloadMaxBytes
+		for cut > 0 && !utf8.RuneStart(payload[cut]) {
+			cut--
+		}
+		value = fmt.Sprintf("%s...[truncated %d bytes]", payload[:cut], len(payload)-cut)
+	}
+	return []attribute.KeyValue{
+		attribute.String(kind+".value", value),
+		attribute.Int(kind+".length", len(payload)),
+	}
+}
@@ -0,0 +1,48 @@
+package telemetry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestToolPayloadAttributes(t *testing.T) {
+	previous := toolPayloadMaxBytes
+	defer func() { toolPayloadMaxBytes = previous }()
+	toolPayloadMaxBytes = 8
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("input.value", `{"a":1}`),
+		attribute.Int("input.length", 7),
+	}, toolPayloadAttributes("input", `{"a":1}`))
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("output.value", "abcdefgh...[truncated 12 bytes]"),
+		attribute.Int("output.length", 20),
+	}, toolPayloadAttributes("output", strings.Repeat("abcdefghij", 2)))
+
+	// The cut never splits a multi-byte character
+	attrs := toolPayloadAttributes("output", "abcdefg€uro")
+	assert.Equal(t, "abcdefg...[truncated 6 bytes]", attrs[0].Value.AsString())
+
+	toolPayloadMaxBytes = 0
+	assert.Equal(t, strings.Repeat("x", 100), toolPayloadAttributes("input", strings.Repeat("x", 100))[0].Value.AsString())
+}
+
+func TestToolPayloadMaxBytesFromEnv(t *testing.T) {
+	limit, err := toolPayloadMaxBytesFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultToolPayloadMaxBytes, limit)
+
+	t.Setenv("ARK_TRACES_TOOL_PAYLOAD_MAX_BYTES", "1024")
+	limit, err = toolPayloadMaxBytesFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 1024, limit)
+
+	t.Setenv("ARK_TRACES_TOOL_PAYLOAD_MAX_BYTES", "lots")
+	_, err = toolPayloadMaxBytesFromEnv()
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/openai/openai-go"
 	"go.opentelemetry.io/otel"
@@ -94,10 +95,13 @@ func (tc *TraceContext) StartEvaluationSpan(ctx context.Context, evaluatorName s
 	)
 }
 
+// RecordError records err on the span and sets an error status whose description is prefixed with the error type
 func RecordError(span trace.Span, err error) {
 	if err != nil {
+		errorType := ClassifyError(err)
 		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(attribute.String("error.type", errorType))
+		span.SetStatus(codes.Error, errorType+": "+err.Error())
 	}
 }
 
@@ -299,14 +303,14 @@ func SetToolInput(span trace.Span, toolCallID, input string) {
 		span.SetAttributes(attribute.String("gen_ai.tool.call.id", toolCallID))
 	}
 	if input != "" {
-		span.SetAttributes(attribute.String("input.value", input))
+		span.SetAttributes(toolPayloadAttributes("input", input)...)
 	}
 }
 
 // SetToolOutput sets output attributes on tool execution span
 func SetToolOutput(span trace.Span, output string) {
 	if output != "" {
-		span.SetAttributes(attribute.String("output.value", output))
+		span.SetAttributes(toolPayloadAttributes("output", output)...)
 	}
 }
 
@@ -344,7 +348,7 @@ func StartToolExecution(ctx context.Context, toolName, toolType, toolCallID, inp
 		span.SetAttributes(attribute.String("gen_ai.tool.call.id", toolCallID))
 	}
 	if input != "" {
-		span.SetAttributes(attribute.String("input.value", input))
+		span.SetAttributes(toolPayloadAttributes("input", input)...)
 	}
 
 	return ctx, span
@@ -353,7 +357,7 @@ func StartToolExecution(ctx context.Context, toolName, toolType, toolCallID, inp
 // RecordToolSuccess records successful tool execution with output
 func RecordToolSuccess(span trace.Span, output string) {
 	if output != "" {
-		span.SetAttributes(attribute.String("output.value", output))
+		span.SetAttributes(toolPayloadAttributes("output", output)...)
 	}
 	RecordSuccess(span)
 }
@@ -363,6 +367,48 @@ func RecordToolError(span trace.Span, err error) {
 	RecordError(span, err)
 }
 
+// StartA2ADiscovery starts a span covering agent card discovery against one A2A server address
+func StartA2ADiscovery(ctx context.Context, address string) (context.Context, trace.Span) {
+	return NewTraceContext().StartSpan(ctx, "a2a.discovery",
+		attribute.String("a2a.server.address", address),
+	)
+}
+
+// RecordDiscoveryAttempt adds a discovery.attempt event for one agent card endpoint. Outcome is success or failure.
+func RecordDiscoveryAttempt(span trace.Span, url, version string, err error) {
+	attrs := []attribute.KeyValue{
+		attribute.String("url", url),
+		attribute.String("version", version),
+	}
+	if err != nil {
+		attrs = append(attrs,
+			attribute.String("outcome", "failure"),
+			attribute.String("error", err.Error()),
+			attribute.String("error.type", ClassifyError(err)),
+		)
+	} else {
+		attrs = append(attrs, attribute.String("outcome", "success"))
+		span.SetAttributes(attribute.String("a2a.protocol.version", version))
+	}
+	span.AddEvent("discovery.attempt", trace.WithAttributes(attrs...))
+}
+
+// StartTeamMember starts a span covering one team member turn
+func StartTeamMember(ctx context.Context, teamName, memberName, memberType, strategy string, turn int) (context.Context, trace.Span) {
+	return NewTraceContext().StartSpan(ctx, "team.member",
+		attribute.String("team.name", teamName),
+		attribute.String("team.strategy", strategy),
+		attribute.String("agent.name", memberName),
+		attribute.String("team.member.type", memberType),
+		attribute.Int("team.member.turn", turn),
+	)
+}
+
+// RecordTeamMemberDuration sets the wall-clock duration of a team member turn
+func RecordTeamMemberDuration(span trace.Span, duration time.Duration) {
+	span.SetAttributes(attribute.Int64("team.member.duration_ms", duration.Milliseconds()))
+}
+
 // Session tracking functions
 
 // StartSessionContext creates a new context with session tracking via OTEL baggage
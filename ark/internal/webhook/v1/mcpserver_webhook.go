@@ -5,6 +5,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"path"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -68,6 +70,16 @@ func (v *MCPServerValidator) ValidateCreate(ctx context.Context, obj runtime.Obj
 		return nil, fmt.Errorf("failed to validate pollInterval: %w", err)
 	}
 
+	if err := validateMCPToolFilter(mcpserver.Spec.ToolFilter); err != nil {
+		mcpserverlog.Error(err, "Failed to validate toolFilter", "mcpserver", mcpserver.GetName())
+		return nil, fmt.Errorf("failed to validate toolFilter: %w", err)
+	}
+
+	if err := validateMCPConnectRetry(mcpserver.Spec.ConnectRetry); err != nil {
+		mcpserverlog.Error(err, "Failed to validate connectRetry", "mcpserver", mcpserver.GetName())
+		return nil, fmt.Errorf("failed to validate connectRetry: %w", err)
+	}
+
 	mcpserverlog.Info("MCPServer validation complete", "name", mcpserver.GetName())
 
 	return nil, nil
@@ -81,6 +93,31 @@ func (v *MCPServerValidator) ValidateDelete(ctx context.Context, obj runtime.Obj
 	return nil, nil
 }
 
+func validateMCPToolFilter(filter *arkv1alpha1.MCPToolFilter) error {
+	if filter == nil {
+		return nil
+	}
+	for _, pattern := range append(slices.Clone(filter.Allow), filter.Deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid tool pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func validateMCPConnectRetry(retry *arkv1alpha1.MCPConnectRetry) error {
+	if retry == nil {
+		return nil
+	}
+	if retry.MaxBackoff != nil && retry.MaxBackoff.Duration < 0 {
+		return fmt.Errorf("maxBackoff cannot be negative")
+	}
+	if retry.MaxElapsedTime != nil && retry.MaxElapsedTime.Duration < 0 {
+		return fmt.Errorf("maxElapsedTime cannot be negative")
+	}
+	return nil
+}
+
 func (v *MCPServerValidator) validateHeaderValue(ctx context.Context, headerValue arkv1alpha1.HeaderValue, namespace string) error {
 	if headerValue.Value != "" {
 		return nil
@@ -94,6 +131,14 @@ func (v *MCPServerValidator) validateHeaderValue(ctx context.Context, headerValu
 		return v.validateSecretKeyRef(ctx, headerValue.ValueFrom.SecretKeyRef, namespace)
 	}
 
+	// The token is minted by the controller when connecting, so only the reference itself is checked here
+	if headerValue.ValueFrom.ServiceAccountTokenRef != nil {
+		if headerValue.ValueFrom.ServiceAccountTokenRef.Name == "" {
+			return fmt.Errorf("service account name is required")
+		}
+		return nil
+	}
+
 	return fmt.Errorf("no valid valueFrom source specified for header")
 }
 
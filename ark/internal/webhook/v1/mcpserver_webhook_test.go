@@ -0,0 +1,87 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+)
+
+var _ = Describe("MCPServer Webhook", func() {
+	var (
+		ctx       context.Context
+		mcpserver *arkv1alpha1.MCPServer
+		validator *MCPServerValidator
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme := runtime.NewScheme()
+		Expect(arkv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		validator = &MCPServerValidator{
+			Client:   fakeClient,
+			Resolver: common.NewValueSourceResolver(fakeClient),
+		}
+
+		mcpserver = &arkv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-mcpserver",
+				Namespace: "default",
+			},
+			Spec: arkv1alpha1.MCPServerSpec{
+				Address:      arkv1alpha1.ValueSource{Value: "http://mcp.example.com/mcp"},
+				PollInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+	})
+
+	Context("When validating header values", func() {
+		It("Should allow a header minted from a service account token", func() {
+			mcpserver.Spec.Headers = []arkv1alpha1.Header{{
+				Name: "Authorization",
+				Value: arkv1alpha1.HeaderValue{
+					ValueFrom: &arkv1alpha1.HeaderValueSource{
+						ServiceAccountTokenRef: &arkv1alpha1.ServiceAccountTokenSelector{
+							Name:   "mcp-client",
+							Prefix: "Bearer ",
+						},
+					},
+				},
+			}}
+
+			warnings, err := validator.ValidateCreate(ctx, mcpserver)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should reject a service account token reference without a name", func() {
+			mcpserver.Spec.Headers = []arkv1alpha1.Header{{
+				Name: "Authorization",
+				Value: arkv1alpha1.HeaderValue{
+					ValueFrom: &arkv1alpha1.HeaderValueSource{
+						ServiceAccountTokenRef: &arkv1alpha1.ServiceAccountTokenSelector{},
+					},
+				},
+			}}
+
+			_, err := validator.ValidateCreate(ctx, mcpserver)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("service account name is required"))
+		})
+	})
+})
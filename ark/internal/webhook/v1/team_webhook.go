@@ -5,6 +5,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -100,6 +101,39 @@ func (v *TeamCustomValidator) validateTeamMembers(ctx context.Context, team *ark
 		return warnings, err
 	}
 
+	timeoutWarnings, err := v.validateTimeout(ctx, team)
+	warnings = append(warnings, timeoutWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, nil
+}
+
+// validateTimeout rejects non-positive team timeouts and warns when a nested team allows
+// more time than this team, since the nested run is cut off at this team's deadline
+func (v *TeamCustomValidator) validateTimeout(ctx context.Context, team *arkv1alpha1.Team) (admission.Warnings, error) {
+	if team.Spec.Timeout == nil {
+		return nil, nil
+	}
+	if team.Spec.Timeout.Duration <= 0 {
+		return nil, fmt.Errorf("team timeout must be positive, got %s", team.Spec.Timeout.Duration)
+	}
+
+	var warnings admission.Warnings
+	for _, member := range team.Spec.Members {
+		if member.Type != MemberTypeTeam {
+			continue
+		}
+		var nested arkv1alpha1.Team
+		if err := v.Client.Get(ctx, types.NamespacedName{Name: member.Name, Namespace: team.Namespace}, &nested); err != nil {
+			continue
+		}
+		if nested.Spec.Timeout != nil && nested.Spec.Timeout.Duration > team.Spec.Timeout.Duration {
+			warnings = append(warnings, fmt.Sprintf("team member '%s' timeout %s exceeds team timeout %s and will be cut off",
+				member.Name, nested.Spec.Timeout.Duration, team.Spec.Timeout.Duration))
+		}
+	}
 	return warnings, nil
 }
 
@@ -131,14 +165,16 @@ func (v *TeamCustomValidator) validateNoMixedTeam(ctx context.Context, team *ark
 
 func (v *TeamCustomValidator) validateStrategy(ctx context.Context, team *arkv1alpha1.Team) error {
 	switch team.Spec.Strategy {
-	case "sequential", "round-robin":
+	case "sequential", "round-robin", "parallel":
 		return nil
 	case "selector":
 		return v.validateSelectorAgent(ctx, team)
 	case "graph":
 		return v.validateGraphStrategy(team)
+	case "router":
+		return v.validateRouterStrategy(ctx, team)
 	default:
-		return fmt.Errorf("unsupported strategy '%s': must be 'sequential', 'round-robin', 'selector', or 'graph'", team.Spec.Strategy)
+		return fmt.Errorf("unsupported strategy '%s': must be 'sequential', 'round-robin', 'parallel', 'selector', 'graph', or 'router'", team.Spec.Strategy)
 	}
 }
 
@@ -154,6 +190,19 @@ func (v *TeamCustomValidator) validateSelectorAgent(ctx context.Context, team *a
 		return fmt.Errorf("selector agent '%s' not found in namespace %s: %v", agentName, team.Namespace, err)
 	}
 
+	if team.Spec.Selector.MaxHistoryMessages < 0 {
+		return fmt.Errorf("selector.maxHistoryMessages must not be negative")
+	}
+
+	if defaultMember := team.Spec.Selector.DefaultMember; defaultMember != "" {
+		for _, member := range team.Spec.Members {
+			if member.Name == defaultMember {
+				return nil
+			}
+		}
+		return fmt.Errorf("selector.defaultMember '%s' not found in team members", defaultMember)
+	}
+
 	return nil
 }
 
@@ -179,8 +228,14 @@ func (v *TeamCustomValidator) validateGraphStrategy(team *arkv1alpha1.Team) erro
 		if !memberNames[edge.To] {
 			return fmt.Errorf("graph edge %d: 'to' member '%s' not found in team members", i, edge.To)
 		}
-		if _, exists := transitionMap[edge.From]; exists {
-			return fmt.Errorf("member '%s' has more than one outgoing edge", edge.From)
+		if edge.Condition != nil {
+			if err := validateGraphEdgeCondition(edge.Condition); err != nil {
+				return fmt.Errorf("graph edge %d: %w", i, err)
+			}
+		}
+		// Several conditions can match the same output, so conditional edges fan out like unconditional ones
+		if _, exists := transitionMap[edge.From]; exists && team.Spec.Graph.MaxConcurrency == nil {
+			return fmt.Errorf("member '%s' has more than one outgoing edge; set graph.maxConcurrency to run successors concurrently", edge.From)
 		}
 		transitionMap[edge.From] = true
 	}
@@ -191,3 +246,52 @@ func (v *TeamCustomValidator) validateGraphStrategy(team *arkv1alpha1.Team) erro
 
 	return nil
 }
+
+func (v *TeamCustomValidator) validateRouterStrategy(ctx context.Context, team *arkv1alpha1.Team) error {
+	router := team.Spec.Router
+	if router == nil {
+		return fmt.Errorf("router strategy requires router configuration")
+	}
+	if len(router.Rules) == 0 && router.Agent == "" && router.DefaultMember == "" {
+		return fmt.Errorf("router strategy requires rules, an agent or a default member")
+	}
+
+	memberNames := make(map[string]bool)
+	for _, member := range team.Spec.Members {
+		memberNames[member.Name] = true
+	}
+
+	for i, rule := range router.Rules {
+		if !memberNames[rule.Member] {
+			return fmt.Errorf("router rule %d: member '%s' not found in team members", i, rule.Member)
+		}
+		condition := &arkv1alpha1.TeamGraphEdgeCondition{Contains: rule.Contains, Matches: rule.Matches}
+		if err := validateGraphEdgeCondition(condition); err != nil {
+			return fmt.Errorf("router rule %d: %w", i, err)
+		}
+	}
+
+	if router.DefaultMember != "" && !memberNames[router.DefaultMember] {
+		return fmt.Errorf("router.defaultMember '%s' not found in team members", router.DefaultMember)
+	}
+
+	if router.Agent != "" {
+		if err := v.ValidateLoadAgent(ctx, router.Agent, team.Namespace); err != nil {
+			return fmt.Errorf("router agent '%s' not found in namespace %s: %v", router.Agent, team.Namespace, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGraphEdgeCondition(condition *arkv1alpha1.TeamGraphEdgeCondition) error {
+	if condition.Contains == "" && condition.Matches == "" {
+		return fmt.Errorf("condition requires contains or matches")
+	}
+	if condition.Matches != "" {
+		if _, err := regexp.Compile(condition.Matches); err != nil {
+			return fmt.Errorf("invalid condition pattern: %w", err)
+		}
+	}
+	return nil
+}
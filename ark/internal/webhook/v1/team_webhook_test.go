@@ -31,6 +31,48 @@ var _ = Describe("Team Webhook", func() {
 		// TODO (user): Add any teardown logic common to all tests
 	})
 
+	Context("When validating graph edges", func() {
+		BeforeEach(func() {
+			maxTurns := 5
+			obj.Spec = arkv1alpha1.TeamSpec{
+				Strategy: "graph",
+				MaxTurns: &maxTurns,
+				Members: []arkv1alpha1.TeamMember{
+					{Name: "classifier", Type: "agent"},
+					{Name: "refund", Type: "agent"},
+					{Name: "billing", Type: "agent"},
+				},
+				Graph: &arkv1alpha1.TeamGraphSpec{
+					Edges: []arkv1alpha1.TeamGraphEdge{
+						{From: "classifier", To: "refund", Condition: &arkv1alpha1.TeamGraphEdgeCondition{Contains: "refund"}},
+						{From: "classifier", To: "billing", Condition: &arkv1alpha1.TeamGraphEdgeCondition{Contains: "billing"}},
+					},
+				},
+			}
+		})
+
+		It("Should deny several conditional outgoing edges without maxConcurrency", func() {
+			err := validator.validateGraphStrategy(obj)
+			Expect(err).To(MatchError(ContainSubstring("member 'classifier' has more than one outgoing edge")))
+		})
+
+		It("Should deny a conditional and an unconditional outgoing edge without maxConcurrency", func() {
+			obj.Spec.Graph.Edges[1].Condition = nil
+			Expect(validator.validateGraphStrategy(obj)).To(HaveOccurred())
+		})
+
+		It("Should admit several conditional outgoing edges with maxConcurrency", func() {
+			maxConcurrency := 2
+			obj.Spec.Graph.MaxConcurrency = &maxConcurrency
+			Expect(validator.validateGraphStrategy(obj)).To(Succeed())
+		})
+
+		It("Should admit a single conditional outgoing edge without maxConcurrency", func() {
+			obj.Spec.Graph.Edges = obj.Spec.Graph.Edges[:1]
+			Expect(validator.validateGraphStrategy(obj)).To(Succeed())
+		})
+	})
+
 	Context("When creating or updating Team under Validating Webhook", func() {
 		// TODO (user): Add logic for validating webhooks
 		// Example:
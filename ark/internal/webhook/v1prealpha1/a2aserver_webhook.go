@@ -83,6 +83,14 @@ func (v *A2AServerValidator) validateA2AServer(a2aServer *arkv1prealpha1.A2AServ
 		allErrs = append(allErrs, err)
 	}
 
+	if a2aServer.Spec.DiscoveryTimeout != nil && a2aServer.Spec.DiscoveryTimeout.Duration < 0 {
+		allErrs = append(allErrs, fmt.Errorf("discoveryTimeout cannot be negative"))
+	}
+
+	if a2aServer.Spec.ExecutionTimeout != nil && a2aServer.Spec.ExecutionTimeout.Duration < 0 {
+		allErrs = append(allErrs, fmt.Errorf("executionTimeout cannot be negative"))
+	}
+
 	if len(allErrs) > 0 {
 		return nil, fmt.Errorf("validation failed: %v", allErrs)
 	}
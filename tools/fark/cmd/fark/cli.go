@@ -11,7 +11,6 @@ import (
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/client-go/dynamic"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 )
@@ -57,7 +56,7 @@ func handleQueryCompletion(result *QueryResult, id *ResourceIdentifier, opts *Ou
 	}
 
 	if result.Phase == "error" {
-		errorMessage := getQueryErrorFromEvents(id.Config.DynamicClient, id.Name, id.Namespace, id.Config.Logger)
+		errorMessage := getQueryErrorFromEvents(id.Config, id.Name, id.Namespace)
 		cleanupQuery(id.Config, id.Name, id.Namespace, id.Config.Logger)
 		return fmt.Errorf("query failed: %s", errorMessage)
 	}
@@ -112,13 +111,16 @@ func waitForQueryCompletion(ctx context.Context, id *ResourceIdentifier, opts *O
 	}
 }
 
-func getQueryErrorFromEvents(client dynamic.Interface, queryName, namespace string, logger *zap.Logger) string {
+func getQueryErrorFromEvents(config *Config, queryName, namespace string) string {
+	ctx, cancel := config.apiContext()
+	defer cancel()
+
 	// Get events for this query, sorted by timestamp
-	events, err := client.Resource(GetGVR(ResourceEvent)).Namespace(namespace).List(context.Background(), metav1.ListOptions{
+	events, err := config.DynamicClient.Resource(GetGVR(ResourceEvent)).Namespace(namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s", queryName),
 	})
 	if err != nil {
-		logger.Warn("Failed to get events", zap.Error(err))
+		config.Logger.Warn("Failed to get events", zap.Error(config.apiError(ctx, err)))
 		return "unknown error"
 	}
 
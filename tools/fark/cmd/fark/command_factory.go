@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -169,7 +168,8 @@ func (cf *CommandFactory) buildLongDescription(targetType ResourceType) string {
 When querying:
 - Query text can be provided directly as arguments after the name, or loaded from a file using --file.
 - Results are streamed in real-time and automatically cleaned up after completion.
-- Use -p key=value to provide template parameters.`
+- Use -p key=value to provide template parameters.
+- Use --param-file key=path to read a long or multiline parameter value from a file.`
 }
 
 func (cf *CommandFactory) buildExamples(targetType ResourceType) string {
@@ -183,8 +183,10 @@ func (cf *CommandFactory) buildExamples(targetType ResourceType) string {
 // isInputRequiredForTool checks if a tool requires input parameters
 func (cf *CommandFactory) isInputRequiredForTool(toolName, namespace string) bool {
 	toolGVR := GetGVR(ResourceTool)
+	ctx, cancel := cf.config.apiContext()
+	defer cancel()
 	toolResource, err := cf.config.DynamicClient.Resource(toolGVR).Namespace(namespace).Get(
-		context.TODO(),
+		ctx,
 		toolName,
 		metav1.GetOptions{},
 	)
@@ -208,13 +210,15 @@ func (cf *CommandFactory) isInputRequiredForTool(toolName, namespace string) boo
 // showToolParameterHelp displays parameter information for a tool
 func (cf *CommandFactory) showToolParameterHelp(toolName, namespace string) error {
 	toolGVR := GetGVR(ResourceTool)
+	ctx, cancel := cf.config.apiContext()
+	defer cancel()
 	toolResource, err := cf.config.DynamicClient.Resource(toolGVR).Namespace(namespace).Get(
-		context.TODO(),
+		ctx,
 		toolName,
 		metav1.GetOptions{},
 	)
 	if err != nil {
-		return fmt.Errorf("failed to get tool %s: %v", toolName, err)
+		return fmt.Errorf("failed to get tool %s: %v", toolName, cf.config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "Tool: %s\n\n", toolName)
@@ -263,8 +267,10 @@ func (cf *CommandFactory) showToolParameterHelp(toolName, namespace string) erro
 func (cf *CommandFactory) convertParametersWithTypes(toolName, namespace string, params []arkv1alpha1.Parameter) (map[string]interface{}, error) {
 	// Get tool schema
 	toolGVR := GetGVR(ResourceTool)
+	ctx, cancel := cf.config.apiContext()
+	defer cancel()
 	toolResource, err := cf.config.DynamicClient.Resource(toolGVR).Namespace(namespace).Get(
-		context.TODO(),
+		ctx,
 		toolName,
 		metav1.GetOptions{},
 	)
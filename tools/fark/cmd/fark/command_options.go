@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"time"
@@ -87,7 +86,9 @@ type TriggerCommand struct {
 	InputFile     string
 	Timeout       time.Duration
 	Parameters    []string
-	SessionId     string
+	// MergeParameters overrides matching parameters of the existing query instead of replacing them all
+	MergeParameters bool
+	SessionId       string
 	ExecutionContext
 }
 
@@ -120,7 +121,11 @@ func (c *TriggerCommand) Run() error {
 		if err != nil {
 			return fmt.Errorf("failed to parse parameters: %v", err)
 		}
-		params = parsedParams
+		if c.MergeParameters {
+			params = mergeParameters(params, parsedParams)
+		} else {
+			params = parsedParams
+		}
 	}
 
 	newQuery, err := createTriggerQuery(existingQuery, queryInput, params, c.SessionId)
@@ -211,10 +216,11 @@ type ResourceIdentifier struct {
 // Get retrieves a resource by name
 func (r *ResourceIdentifier) Get(jsonOutput bool) error {
 	gvr := GetGVR(r.Type)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 	resource, err := r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Get(ctx, r.Name, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get %s '%s': %v", r.Type, r.Name, err)
+		return fmt.Errorf("failed to get %s '%s': %v", r.Type, r.Name, r.Config.apiError(ctx, err))
 	}
 
 	if jsonOutput {
@@ -227,10 +233,11 @@ func (r *ResourceIdentifier) Get(jsonOutput bool) error {
 // Delete deletes a resource
 func (r *ResourceIdentifier) Delete() error {
 	gvr := GetGVR(r.Type)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 	err := r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Delete(ctx, r.Name, metav1.DeleteOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to delete %s '%s': %v", r.Type, r.Name, err)
+		return fmt.Errorf("failed to delete %s '%s': %v", r.Type, r.Name, r.Config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "%s '%s' deleted\n", r.Type, r.Name)
@@ -254,10 +261,11 @@ func (r *ResourceIdentifier) CreateFromFile(filename string) error {
 	resource.SetNamespace(r.Namespace)
 
 	gvr := GetGVR(r.Type)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 	_, err = r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Create(ctx, &resource, metav1.CreateOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to create %s: %v", r.Type, err)
+		return fmt.Errorf("failed to create %s: %v", r.Type, r.Config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "%s '%s' created successfully\n", r.Type, r.Name)
@@ -281,10 +289,11 @@ func (r *ResourceIdentifier) UpdateFromFile(filename string) error {
 	resource.SetNamespace(r.Namespace)
 
 	gvr := GetGVR(r.Type)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 	_, err = r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Update(ctx, &resource, metav1.UpdateOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to update %s: %v", r.Type, err)
+		return fmt.Errorf("failed to update %s: %v", r.Type, r.Config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "%s '%s' updated successfully\n", r.Type, r.Name)
@@ -361,10 +370,11 @@ func (r *ResourceIdentifier) createAgentFromFlags(spec *AgentSpec) error {
 	resource := &unstructured.Unstructured{Object: unstructuredObj}
 
 	gvr := GetGVR(ResourceAgent)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 	_, err = r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Create(ctx, resource, metav1.CreateOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to create agent: %v", err)
+		return fmt.Errorf("failed to create agent: %v", r.Config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "agent '%s' created successfully\n", r.Name)
@@ -374,12 +384,13 @@ func (r *ResourceIdentifier) createAgentFromFlags(spec *AgentSpec) error {
 // updateAgentFromFlags updates an agent from flags
 func (r *ResourceIdentifier) updateAgentFromFlags(spec *AgentSpec) error {
 	gvr := GetGVR(ResourceAgent)
-	ctx := context.Background()
+	ctx, cancel := r.Config.apiContext()
+	defer cancel()
 
 	// Get existing agent
 	resource, err := r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Get(ctx, r.Name, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get agent '%s': %v", r.Name, err)
+		return fmt.Errorf("failed to get agent '%s': %v", r.Name, r.Config.apiError(ctx, err))
 	}
 
 	// Update fields if provided
@@ -395,7 +406,7 @@ func (r *ResourceIdentifier) updateAgentFromFlags(spec *AgentSpec) error {
 
 	_, err = r.Config.DynamicClient.Resource(gvr).Namespace(r.Namespace).Update(ctx, resource, metav1.UpdateOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to update agent: %v", err)
+		return fmt.Errorf("failed to update agent: %v", r.Config.apiError(ctx, err))
 	}
 
 	fmt.Fprintf(os.Stderr, "agent '%s' updated successfully\n", r.Name)
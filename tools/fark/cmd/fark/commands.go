@@ -44,12 +44,14 @@ func createQueryCommand(config *Config) *cobra.Command {
 When triggering a query:
 - Query text can be provided directly as arguments after the query name, or loaded from a file using --file.
 - Results are streamed in real-time and automatically cleaned up after completion.
-- Use -p key=value to override template parameters.`,
+- Use -p key=value to override template parameters.
+- Use --param-file key=path to read a long or multiline parameter value from a file.`,
 		Example: `  fark query
   fark query my-query
   fark query my-query "New input text"
   fark query my-query -f input.txt -n my-namespace
-  fark query my-query -p name=John -p condition=sunny`,
+  fark query my-query -p name=John -p condition=sunny
+  fark query my-query --param-file document=report.md`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := f.validate(); err != nil {
 				return err
@@ -101,9 +103,102 @@ When triggering a query:
 	}
 
 	f.addTo(queryCmd)
+	queryCmd.AddCommand(createQueryReplayCommand(config))
+	queryCmd.AddCommand(createQueryTokensCommand(config))
 	return queryCmd
 }
 
+func createQueryTokensCommand(config *Config) *cobra.Command {
+	var namespace string
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "tokens <query-name>",
+		Short: "Show token usage of a query",
+		Long:  `Show the prompt, completion and total tokens recorded on a query.`,
+		Example: `  fark query tokens weather-query
+  fark query tokens weather-query -n production --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ns := getNamespaceOrDefault(namespace, config.Namespace)
+			return runQueryTokensCommand(config, args[0], ns, jsonOutput)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return getResourceCompletions(config, "queries", namespace), cobra.ShellCompDirectiveNoFileComp
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to configured namespace)")
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output results in JSON format only")
+	return cmd
+}
+
+func createQueryReplayCommand(config *Config) *cobra.Command {
+	f := &flags{timeout: 5 * time.Minute}
+
+	replayCmd := &cobra.Command{
+		Use:   "replay <query-name> [query text...]",
+		Short: "Replay a query with new input",
+		Long: `Replay an existing query against the same targets, selector, memory and session with new input.
+
+- The new input is required and can be provided as arguments, with --input, or loaded from a file using --file.
+- Use -p key=value to override individual parameters; other parameters of the original query are kept.`,
+		Example: `  fark query replay weather-query "What's the weather in London?"
+  fark query replay weather-query -i "Try again" -p city=London
+  fark query replay weather-query -f prompt.txt --session-id my-session`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := f.validate(); err != nil {
+				return err
+			}
+
+			resolver := &InputResolver{
+				Input:     f.input,
+				InputFile: f.inputFile,
+				Args:      args[1:],
+				Required:  true,
+			}
+			input, err := resolver.Resolve()
+			if err != nil {
+				return err
+			}
+
+			opts := TriggerCommand{
+				QueryName:       args[0],
+				InputOverride:   input,
+				Timeout:         f.timeout,
+				Parameters:      f.parameters,
+				MergeParameters: true,
+				SessionId:       f.sessionId,
+				ExecutionContext: ExecutionContext{
+					Config:     config,
+					Namespace:  getNamespaceOrDefault(f.namespace, config.Namespace),
+					JSONOutput: f.outputMode == "json",
+					Silent:     f.quiet,
+					Verbose:    f.verbose,
+				},
+			}
+			return handleQueryError(cmd, opts.Run())
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return getResourceCompletions(config, "queries", f.namespace), cobra.ShellCompDirectiveNoFileComp
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	f.addTo(replayCmd)
+	return replayCmd
+}
+
 type listCommandConfig struct {
 	use         string
 	short       string
@@ -131,6 +226,7 @@ func setupRoutes(config *Config) {
 func createGetCommand(config *Config) *cobra.Command {
 	var namespace string
 	var jsonOutput bool
+	var schemaOutput bool
 
 	cmd := &cobra.Command{
 		Use:   "get <resource> [name]",
@@ -141,18 +237,26 @@ Supported resources: agent, team, model, tool, query`,
 		Example: `  fark get agent                    # List all agents
   fark get agent weather-agent      # Get specific agent
   fark get team weather-team -n production
-  fark get tool get-forecast --json`,
+  fark get tool get-forecast --json
+  fark get agent --schema           # JSON schema of 'fark get agent --json'`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resourceType := args[0]
 			ns := getNamespaceOrDefault(namespace, config.Namespace)
 
+			if schemaOutput && len(args) > 1 {
+				return fmt.Errorf("--schema describes the listing output and cannot be used with a resource name")
+			}
+
 			if len(args) == 1 {
 				// List resources
 				resourceTypeEnum := getResourceTypeFromString(resourceType)
 				if resourceTypeEnum == "" {
 					return fmt.Errorf("unsupported resource type: %s", resourceType)
 				}
+				if schemaOutput {
+					return printListOutputSchema(resourceTypeEnum)
+				}
 				return runListResourcesCommand(config, resourceTypeEnum, ns, jsonOutput)
 			} else {
 				// Get specific resource
@@ -182,6 +286,7 @@ Supported resources: agent, team, model, tool, query`,
 
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to configured namespace)")
 	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output results in JSON format only")
+	cmd.Flags().BoolVar(&schemaOutput, "schema", false, "Print the JSON schema of the --json listing output")
 	return cmd
 }
 
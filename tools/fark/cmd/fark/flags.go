@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ type flags struct {
 	quiet      bool   // Suppress events and progress indicators
 	namespace  string
 	parameters []string
+	paramFiles []string
 	sessionId  string
 }
 
@@ -28,6 +30,7 @@ func (f *flags) addTo(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.quiet, "quiet", "q", false, "Suppress event logs (spinner still shown)")
 	cmd.Flags().StringVarP(&f.namespace, "namespace", "n", "", "Namespace (defaults to configured namespace)")
 	cmd.Flags().StringArrayVarP(&f.parameters, "param", "p", nil, "Template parameters in key=value format (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&f.paramFiles, "param-file", nil, "Template parameters read from files in key=path format (max 3MB, can be used multiple times)")
 	cmd.Flags().StringVar(&f.sessionId, "session-id", "", "Session ID to associate with the query")
 }
 
@@ -49,5 +52,31 @@ func (f *flags) validate() error {
 	if f.outputMode != "text" && f.outputMode != "json" {
 		return fmt.Errorf("invalid output mode: %s. Must be 'text' or 'json'", f.outputMode)
 	}
+
+	if err := f.resolveParamFiles(); err != nil {
+		return err
+	}
+	if _, err := parseParameters(f.parameters); err != nil {
+		return err
+	}
+	return nil
+}
+
+// resolveParamFiles reads --param-file values and adds them to the parameters as key=content
+func (f *flags) resolveParamFiles() error {
+	for _, paramFile := range f.paramFiles {
+		key, path, found := strings.Cut(paramFile, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" || path == "" {
+			return fmt.Errorf("parameter file must be in key=path format, got: %s", paramFile)
+		}
+
+		content, err := readInputFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read parameter file for %s: %v", key, err)
+		}
+		f.parameters = append(f.parameters, key+"="+content)
+	}
+	f.paramFiles = nil
 	return nil
 }
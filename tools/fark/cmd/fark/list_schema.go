@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// listOutputSchema describes the JSON printed when listing resources with --json.
+// Each item is the full resource; only the fields below are guaranteed.
+func listOutputSchema(resourceType ResourceType) map[string]any {
+	gvr := GetGVR(resourceType)
+	return map[string]any{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"title":       fmt.Sprintf("fark %s listing", resourceType),
+		"description": fmt.Sprintf("Array of %s resources in the requested namespace, as returned by the Kubernetes API", resourceType),
+		"type":        "array",
+		"items": map[string]any{
+			"type":     "object",
+			"required": []string{"apiVersion", "kind", "metadata"},
+			"properties": map[string]any{
+				"apiVersion": map[string]any{"type": "string", "const": gvr.GroupVersion().String()},
+				"kind":       map[string]any{"type": "string"},
+				"metadata": map[string]any{
+					"type":     "object",
+					"required": []string{"name", "namespace"},
+					"properties": map[string]any{
+						"name":              map[string]any{"type": "string"},
+						"namespace":         map[string]any{"type": "string"},
+						"creationTimestamp": map[string]any{"type": "string", "format": "date-time"},
+						"labels":            map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}},
+						"annotations":       map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}},
+					},
+				},
+				"spec": map[string]any{"type": "object"},
+				"status": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"phase": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func printListOutputSchema(resourceType ResourceType) error {
+	jsonData, err := json.MarshalIndent(listOutputSchema(resourceType), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %v", err)
+	}
+	fmt.Println(string(jsonData))
+	return nil
+}
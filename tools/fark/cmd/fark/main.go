@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/dynamic"
@@ -42,6 +43,7 @@ func initializeConfig() *Config {
 		namespace = "default"
 	}
 	port := "8080"
+	apiTimeout := 30 * time.Second
 
 	logger := initLogger()
 
@@ -50,6 +52,7 @@ func initializeConfig() *Config {
 		Namespace:     namespace,
 		Port:          port,
 		Logger:        logger,
+		APITimeout:    apiTimeout,
 	}
 }
 
@@ -74,6 +77,8 @@ agentic workloads across Kubernetes namespaces.`,
 		SilenceErrors: true,
 	}
 
+	rootCmd.PersistentFlags().DurationVar(&config.APITimeout, "api-timeout", config.APITimeout, "Timeout for each Kubernetes API request (0 disables it)")
+
 	cf := NewCommandFactory(config)
 	rootCmd.AddCommand(createServerCommand(config))
 	rootCmd.AddCommand(cf.CreateTargetCommand(ResourceAgent, "agent [agent-name] [request...]", "Query agents"))
@@ -81,6 +86,8 @@ agentic workloads across Kubernetes namespaces.`,
 	rootCmd.AddCommand(cf.CreateTargetCommand(ResourceModel, "model [model-name] [query...]", "Query models"))
 	rootCmd.AddCommand(cf.CreateTargetCommand(ResourceTool, "tool [tool-name] [request...]", "Query tools"))
 	rootCmd.AddCommand(createQueryCommand(config))
+	rootCmd.AddCommand(createSessionCommand(config))
+	rootCmd.AddCommand(createWaitCommand(config))
 
 	// Add CRUD commands
 	rootCmd.AddCommand(createGetCommand(config))
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -45,12 +44,14 @@ func submitQuery(config *Config, query *arkv1alpha1.Query) error {
 		return fmt.Errorf("failed to convert query: %v", err)
 	}
 
+	ctx, cancel := config.apiContext()
+	defer cancel()
 	_, err = config.DynamicClient.Resource(GetGVR(ResourceQuery)).Namespace(query.Namespace).Create(
-		context.TODO(),
+		ctx,
 		unstructuredQuery,
 		metav1.CreateOptions{},
 	)
-	return err
+	return config.apiError(ctx, err)
 }
 
 func convertToUnstructured(query *arkv1alpha1.Query) (*unstructured.Unstructured, error) {
@@ -114,21 +115,26 @@ func getResourceName(resource map[string]any) (string, bool) {
 }
 
 func deleteQuery(config *Config, queryName, namespace string) error {
-	return config.DynamicClient.Resource(GetGVR(ResourceQuery)).Namespace(namespace).Delete(
-		context.TODO(),
+	ctx, cancel := config.apiContext()
+	defer cancel()
+	err := config.DynamicClient.Resource(GetGVR(ResourceQuery)).Namespace(namespace).Delete(
+		ctx,
 		queryName,
 		metav1.DeleteOptions{},
 	)
+	return config.apiError(ctx, err)
 }
 
 func getExistingQuery(config *Config, queryName, namespace string) (*arkv1alpha1.Query, error) {
+	ctx, cancel := config.apiContext()
+	defer cancel()
 	unstructuredQuery, err := config.DynamicClient.Resource(GetGVR(ResourceQuery)).Namespace(namespace).Get(
-		context.TODO(),
+		ctx,
 		queryName,
 		metav1.GetOptions{},
 	)
 	if err != nil {
-		return nil, err
+		return nil, config.apiError(ctx, err)
 	}
 
 	var query arkv1alpha1.Query
@@ -146,6 +152,33 @@ func getExistingQuery(config *Config, queryName, namespace string) (*arkv1alpha1
 	return &query, nil
 }
 
+func runQueryTokensCommand(config *Config, queryName, namespace string, jsonOutput bool) error {
+	query, err := getExistingQuery(config, queryName, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get query '%s': %v", queryName, err)
+	}
+
+	usage := query.Status.TokenUsage
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(map[string]any{
+			"query":      query.Name,
+			"namespace":  query.Namespace,
+			"phase":      query.Status.Phase,
+			"tokenUsage": usage,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		fmt.Println(string(jsonData))
+		return nil
+	}
+
+	fmt.Printf("Prompt tokens:     %d\n", usage.PromptTokens)
+	fmt.Printf("Completion tokens: %d\n", usage.CompletionTokens)
+	fmt.Printf("Total tokens:      %d\n", usage.TotalTokens)
+	return nil
+}
+
 func getSessionId(provided, existing string) string {
 	if provided != "" {
 		return provided
@@ -153,6 +186,25 @@ func getSessionId(provided, existing string) string {
 	return existing
 }
 
+// mergeParameters returns the existing parameters with matching names replaced by the overrides and new ones appended
+func mergeParameters(existing, overrides []arkv1alpha1.Parameter) []arkv1alpha1.Parameter {
+	merged := append([]arkv1alpha1.Parameter{}, existing...)
+	for _, override := range overrides {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == override.Name {
+				merged[i] = override
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
 func createTriggerQuery(existingQuery *arkv1alpha1.Query, input runtime.RawExtension, params []arkv1alpha1.Parameter, sessionId string) (*arkv1alpha1.Query, error) {
 	queryName := fmt.Sprintf("trigger-%d", time.Now().Unix())
 
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,13 +22,14 @@ func (rm *ResourceManager) ListResources(resourceType ResourceType, namespace st
 }
 
 func (rm *ResourceManager) listResourcesByGVR(gvr schema.GroupVersionResource, namespace string) ([]map[string]any, error) {
-	ctx := context.Background()
+	ctx, cancel := rm.config.apiContext()
+	defer cancel()
 	unstructuredList, err := rm.config.DynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list resources: %v", err)
+		return nil, fmt.Errorf("failed to list resources: %v", rm.config.apiError(ctx, err))
 	}
 
-	var resources []map[string]any
+	resources := make([]map[string]any, 0, len(unstructuredList.Items))
 	for _, item := range unstructuredList.Items {
 		resourceMap := make(map[string]any)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &resourceMap); err != nil {
@@ -43,12 +43,14 @@ func (rm *ResourceManager) listResourcesByGVR(gvr schema.GroupVersionResource, n
 
 func (rm *ResourceManager) GetResourceNames(resourceType ResourceType, namespace string) ([]string, error) {
 	gvr := GetGVR(resourceType)
+	ctx, cancel := rm.config.apiContext()
+	defer cancel()
 	resources, err := rm.config.DynamicClient.Resource(gvr).Namespace(namespace).List(
-		context.TODO(),
+		ctx,
 		metav1.ListOptions{},
 	)
 	if err != nil {
-		return nil, err
+		return nil, rm.config.apiError(ctx, err)
 	}
 
 	var names []string
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const sessionInputPreviewLength = 60
+
+func createSessionCommand(config *Config) *cobra.Command {
+	sessionCmd := &cobra.Command{
+		Use:   "session",
+		Short: "Inspect query sessions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	sessionCmd.AddCommand(createSessionShowCommand(config))
+	return sessionCmd
+}
+
+func createSessionShowCommand(config *Config) *cobra.Command {
+	var namespace string
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "show <session-id>",
+		Short: "List the queries of a session in order",
+		Long: `List all queries in the namespace that belong to a session, oldest first.
+
+A query belongs to a session when its spec.sessionId matches, or when it has no session ID and its UID matches.
+Continue a session by passing --session-id to agent, team, model, tool or query commands.`,
+		Example: `  fark session show weather-session
+  fark session show weather-session -n production --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ns := getNamespaceOrDefault(namespace, config.Namespace)
+			return runSessionShowCommand(config, args[0], ns, jsonOutput)
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to configured namespace)")
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output results in JSON format only")
+	return cmd
+}
+
+func runSessionShowCommand(config *Config, sessionId, namespace string, jsonOutput bool) error {
+	rm := NewResourceManager(config)
+	queries, err := rm.ListResources(ResourceQuery, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %v", ResourceQuery, err)
+	}
+
+	sessionQueries := filterSessionQueries(queries, sessionId)
+
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(sessionQueries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		fmt.Println(string(jsonData))
+		return nil
+	}
+
+	if len(sessionQueries) == 0 {
+		fmt.Fprintf(os.Stderr, "no queries found for session '%s' in namespace %s\n", sessionId, namespace)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tCREATED\tTOKENS\tINPUT")
+	for _, query := range sessionQueries {
+		name, _ := getResourceName(query)
+		totalTokens, _, _ := unstructured.NestedInt64(query, "status", "tokenUsage", "totalTokens")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+			name,
+			getResourceStatus(query),
+			getNestedString(query, "metadata", "creationTimestamp"),
+			totalTokens,
+			previewQueryInput(query),
+		)
+	}
+	return w.Flush()
+}
+
+// filterSessionQueries returns the queries of a session ordered by creation time
+func filterSessionQueries(queries []map[string]any, sessionId string) []map[string]any {
+	sessionQueries := make([]map[string]any, 0)
+	for _, query := range queries {
+		querySession := getNestedString(query, "spec", "sessionId")
+		if querySession == "" {
+			querySession = getNestedString(query, "metadata", "uid")
+		}
+		if querySession == sessionId {
+			sessionQueries = append(sessionQueries, query)
+		}
+	}
+
+	sort.SliceStable(sessionQueries, func(i, j int) bool {
+		return getNestedString(sessionQueries[i], "metadata", "creationTimestamp") <
+			getNestedString(sessionQueries[j], "metadata", "creationTimestamp")
+	})
+	return sessionQueries
+}
+
+func previewQueryInput(query map[string]any) string {
+	input, found, _ := unstructured.NestedFieldNoCopy(query, "spec", "input")
+	if !found {
+		return ""
+	}
+
+	text, ok := input.(string)
+	if !ok {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return ""
+		}
+		text = string(data)
+	}
+
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	if len(runes) > sessionInputPreviewLength {
+		return string(runes[:sessionInputPreviewLength-3]) + "..."
+	}
+	return string(runes)
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,6 +17,23 @@ type Config struct {
 	Namespace     string
 	Port          string
 	Logger        *zap.Logger
+	APITimeout    time.Duration
+}
+
+// apiContext returns a context bounding a single Kubernetes API operation by the configured API timeout
+func (c *Config) apiContext() (context.Context, context.CancelFunc) {
+	if c.APITimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), c.APITimeout)
+}
+
+// apiError replaces the error of an API operation that ran out of time with a clear timeout error
+func (c *Config) apiError(ctx context.Context, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("kubernetes API did not respond within %s (set --api-timeout to change)", c.APITimeout)
+	}
+	return err
 }
 
 type ResourceType string
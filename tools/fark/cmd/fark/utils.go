@@ -37,6 +37,7 @@ func readInputFile(filePath string) (string, error) {
 
 func parseParameters(parameters []string) ([]arkv1alpha1.Parameter, error) {
 	var result []arkv1alpha1.Parameter
+	seen := make(map[string]bool)
 
 	for _, param := range parameters {
 		parts := strings.SplitN(param, "=", 2)
@@ -50,6 +51,10 @@ func parseParameters(parameters []string) ([]arkv1alpha1.Parameter, error) {
 		if key == "" {
 			return nil, fmt.Errorf("parameter key cannot be empty in: %s", param)
 		}
+		if seen[key] {
+			return nil, fmt.Errorf("parameter %s is specified more than once", key)
+		}
+		seen[key] = true
 
 		result = append(result, arkv1alpha1.Parameter{
 			Name:  key,
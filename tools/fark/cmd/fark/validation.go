@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -12,13 +11,15 @@ import (
 
 func validateToolParameters(config *Config, toolName, namespace, input string) error {
 	toolGVR := GetGVR(ResourceTool)
+	ctx, cancel := config.apiContext()
+	defer cancel()
 	toolResource, err := config.DynamicClient.Resource(toolGVR).Namespace(namespace).Get(
-		context.TODO(),
+		ctx,
 		toolName,
 		metav1.GetOptions{},
 	)
 	if err != nil {
-		return fmt.Errorf("failed to get tool %s: %v", toolName, err)
+		return fmt.Errorf("failed to get tool %s: %v", toolName, config.apiError(ctx, err))
 	}
 
 	inputSchemaRaw, found, err := unstructured.NestedMap(toolResource.Object, "spec", "inputSchema")
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func createWaitCommand(config *Config) *cobra.Command {
+	var namespace string
+	timeout := 5 * time.Minute
+
+	cmd := &cobra.Command{
+		Use:   "wait query <query-name>",
+		Short: "Wait for a query to complete",
+		Long: `Wait until a query has the Completed condition set to True.
+
+Exits with an error when the query finishes with an error, is canceled, or the timeout is reached.
+This is equivalent to: kubectl wait --for=condition=Completed query/<query-name>`,
+		Example: `  fark wait query my-query
+  fark wait query my-query --timeout 10m -n production`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if args[0] != "query" {
+				return fmt.Errorf("unsupported resource type '%s': only query is supported", args[0])
+			}
+			ns := getNamespaceOrDefault(namespace, config.Namespace)
+			ctx := setupQueryContext(timeout, config.Logger)
+			return waitForQueryCompleted(ctx, config, args[1], ns)
+		},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to configured namespace)")
+	cmd.Flags().DurationVar(&timeout, "timeout", timeout, "Maximum time to wait for the query to complete")
+	return cmd
+}
+
+func waitForQueryCompleted(ctx context.Context, config *Config, queryName, namespace string) error {
+	queryWatch, err := config.DynamicClient.Resource(GetGVR(ResourceQuery)).Namespace(namespace).Watch(
+		ctx,
+		metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", queryName).String(),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to watch query '%s': %v", queryName, err)
+	}
+	defer queryWatch.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for query '%s' to complete", queryName)
+		case event, ok := <-queryWatch.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch for query '%s' closed before it completed", queryName)
+			}
+			if event.Type == watch.Deleted {
+				return fmt.Errorf("query '%s' was deleted before it completed", queryName)
+			}
+
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			var query arkv1alpha1.Query
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &query); err != nil {
+				return fmt.Errorf("failed to convert query '%s': %v", queryName, err)
+			}
+
+			if done, err := queryCompletedResult(&query); done {
+				return err
+			}
+		}
+	}
+}
+
+// queryCompletedResult reports whether the query has completed and, if so, whether it failed
+func queryCompletedResult(query *arkv1alpha1.Query) (bool, error) {
+	condition := meta.FindStatusCondition(query.Status.Conditions, string(arkv1alpha1.QueryCompleted))
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return false, nil
+	}
+
+	switch condition.Reason {
+	case "QueryErrored":
+		return true, fmt.Errorf("query '%s' failed: %s", query.Name, condition.Message)
+	case "QueryCanceled":
+		return true, fmt.Errorf("query '%s' was canceled", query.Name)
+	}
+	fmt.Printf("query '%s' completed\n", query.Name)
+	return true, nil
+}